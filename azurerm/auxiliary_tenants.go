@@ -0,0 +1,90 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
+)
+
+// auxiliaryAuthorizationHeader is the header ARM uses to authorize operations which reference
+// resources in Tenants other than the one the request was authenticated against
+const auxiliaryAuthorizationHeader = "x-ms-authorization-auxiliary"
+
+// auxiliaryTenantAuthorizer authorizes requests using the token for the Primary Tenant, and sends a
+// token for each of the Auxiliary Tenants in the `x-ms-authorization-auxiliary` header - which allows
+// Resource Manager to link resources across Tenants (e.g. Virtual Network Peerings) in a single request.
+type auxiliaryTenantAuthorizer struct {
+	primary     autorest.Authorizer
+	auxiliaries []adal.OAuthTokenProvider
+}
+
+func (a auxiliaryTenantAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := a.primary.WithAuthorization()(p).Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			tokens := make([]string, 0, len(a.auxiliaries))
+			for _, provider := range a.auxiliaries {
+				if refresher, ok := provider.(adal.Refresher); ok {
+					if err := refresher.EnsureFresh(); err != nil {
+						return r, fmt.Errorf("Error refreshing the token for an Auxiliary Tenant: %+v", err)
+					}
+				}
+
+				tokens = append(tokens, fmt.Sprintf("Bearer %s", provider.OAuthToken()))
+			}
+
+			if len(tokens) == 0 {
+				return r, nil
+			}
+
+			return autorest.Prepare(r, autorest.WithHeader(auxiliaryAuthorizationHeader, strings.Join(tokens, ", ")))
+		})
+	}
+}
+
+// getAuxiliaryTenantTokens returns a token for the Service Principal in each of the Auxiliary Tenants.
+func getAuxiliaryTenantTokens(c *authentication.Config, activeDirectoryEndpoint string, endpoint string) ([]adal.OAuthTokenProvider, error) {
+	tokens := make([]adal.OAuthTokenProvider, 0, len(c.AuxiliaryTenantIDs))
+
+	for _, tenantId := range c.AuxiliaryTenantIDs {
+		oauthConfig, err := adal.NewOAuthConfig(activeDirectoryEndpoint, tenantId)
+		if err != nil {
+			return nil, fmt.Errorf("Error building the OAuth Config for Auxiliary Tenant %q: %+v", tenantId, err)
+		}
+
+		// OAuthConfigForTenant returns a pointer, which can be nil.
+		if oauthConfig == nil {
+			return nil, fmt.Errorf("Unable to configure OAuthConfig for Auxiliary Tenant %q", tenantId)
+		}
+
+		var spt *adal.ServicePrincipalToken
+		if c.ClientCertPath != "" {
+			certificate, privateKey, err := decodeClientCertificate(c.ClientCertPath, c.ClientCertPassword)
+			if err != nil {
+				return nil, err
+			}
+
+			spt, err = adal.NewServicePrincipalTokenFromCertificate(*oauthConfig, c.ClientID, certificate, privateKey, endpoint)
+			if err != nil {
+				return nil, fmt.Errorf("Error obtaining a token for Auxiliary Tenant %q: %+v", tenantId, err)
+			}
+		} else {
+			spt, err = adal.NewServicePrincipalToken(*oauthConfig, c.ClientID, c.ClientSecret, endpoint)
+			if err != nil {
+				return nil, fmt.Errorf("Error obtaining a token for Auxiliary Tenant %q: %+v", tenantId, err)
+			}
+		}
+
+		tokens = append(tokens, spt)
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,89 @@
+package azurerm
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+type testStaticToken string
+
+func (t testStaticToken) OAuthToken() string {
+	return string(t)
+}
+
+func TestAuxiliaryTenantAuthorizer(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		Auxiliaries       []adal.OAuthTokenProvider
+		ExpectedAuxiliary string
+	}{
+		{
+			Name:              "No Auxiliary Tenants",
+			Auxiliaries:       []adal.OAuthTokenProvider{},
+			ExpectedAuxiliary: "",
+		},
+		{
+			Name:              "Single Auxiliary Tenant",
+			Auxiliaries:       []adal.OAuthTokenProvider{testStaticToken("tenant-b")},
+			ExpectedAuxiliary: "Bearer tenant-b",
+		},
+		{
+			Name:              "Multiple Auxiliary Tenants",
+			Auxiliaries:       []adal.OAuthTokenProvider{testStaticToken("tenant-b"), testStaticToken("tenant-c")},
+			ExpectedAuxiliary: "Bearer tenant-b, Bearer tenant-c",
+		},
+	}
+
+	for _, v := range testCases {
+		auth := auxiliaryTenantAuthorizer{
+			primary:     autorest.NewBearerAuthorizer(testStaticToken("tenant-a")),
+			auxiliaries: v.Auxiliaries,
+		}
+
+		req, err := autorest.Prepare(&http.Request{}, auth.WithAuthorization())
+		if err != nil {
+			t.Fatalf("Error authorizing the request for %q: %+v", v.Name, err)
+		}
+
+		if actual := req.Header.Get("Authorization"); actual != "Bearer tenant-a" {
+			t.Fatalf("Expected the Authorization header for %q to be %q but got %q", v.Name, "Bearer tenant-a", actual)
+		}
+
+		if actual := req.Header.Get(auxiliaryAuthorizationHeader); actual != v.ExpectedAuxiliary {
+			t.Fatalf("Expected the Auxiliary Authorization header for %q to be %q but got %q", v.Name, v.ExpectedAuxiliary, actual)
+		}
+	}
+}
+
+func TestArmClientForSubscription(t *testing.T) {
+	client := &ArmClient{
+		subscriptionId:          "00000000-0000-0000-0000-000000000000",
+		resourceManagerEndpoint: "https://management.azure.com/",
+		resourceManagerAuth:     autorest.NullAuthorizer{},
+	}
+
+	otherId := "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/hub/providers/Microsoft.Network/virtualNetworks/hub"
+	if actual := client.subscriptionIdFromResourceId(otherId); actual != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("Expected the Subscription ID to be parsed from the Resource ID but got %q", actual)
+	}
+
+	if actual := client.subscriptionIdFromResourceId(""); actual != client.subscriptionId {
+		t.Fatalf("Expected the Provider's Subscription ID for an empty Resource ID but got %q", actual)
+	}
+
+	if actual := client.vnetPeeringsClientForSubscription("00000000-0000-0000-0000-000000000000").SubscriptionID; actual != client.subscriptionId {
+		t.Fatalf("Expected the Provider's client to be returned but got one for %q", actual)
+	}
+
+	remote := client.vnetPeeringsClientForSubscription("11111111-1111-1111-1111-111111111111")
+	if remote.SubscriptionID != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("Expected a client for the remote Subscription but got one for %q", remote.SubscriptionID)
+	}
+
+	if remote.BaseURI != client.resourceManagerEndpoint {
+		t.Fatalf("Expected the remote client to use %q but got %q", client.resourceManagerEndpoint, remote.BaseURI)
+	}
+}
@@ -0,0 +1,364 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// totalRegionalVCPUsUsage is the name of the usage which limits the total number of vCPUs in a location,
+// regardless of the family of the Virtual Machines
+const totalRegionalVCPUsUsage = "cores"
+
+// vmSizeCount is the number of Virtual Machines of a particular size which a resource uses
+type vmSizeCount struct {
+	size  string
+	count int
+}
+
+// computeCapacityResources are the resources which use Virtual Machines, along with a function which returns
+// the sizes (and number) of the Virtual Machines from the attributes of the resource
+var computeCapacityResources = map[string]func(attributes map[string]string) []vmSizeCount{
+	"azurerm_kubernetes_cluster": func(attributes map[string]string) []vmSizeCount {
+		return nestedVMSizeCounts(attributes, "agent_pool_profile", "vm_size", "count")
+	},
+	"azurerm_virtual_machine": func(attributes map[string]string) []vmSizeCount {
+		return []vmSizeCount{{size: attributes["vm_size"], count: 1}}
+	},
+	"azurerm_virtual_machine_scale_set": func(attributes map[string]string) []vmSizeCount {
+		return nestedVMSizeCounts(attributes, "sku", "name", "capacity")
+	},
+}
+
+// validateComputeCapacity checks that the Virtual Machine sizes used by the resource are available (and not
+// restricted) in the location, and that the remaining vCPU quota for the Subscription is sufficient for it along
+// with the other resources which have been planned - such that these are reported when planning, rather than once
+// other resources have been created.
+func (p *armProvider) validateComputeCapacity(info *terraform.InstanceInfo, s *terraform.InstanceState, d *terraform.InstanceDiff) error {
+	sizeCounts, ok := computeCapacityResources[info.Type]
+	if !ok {
+		return nil
+	}
+
+	client, ok := p.Meta().(*ArmClient)
+	if !ok {
+		return nil
+	}
+
+	if d == nil || d.Empty() || d.GetDestroy() {
+		client.computeCapacity.forgetPlannedVCPUs(info.HumanId())
+		return nil
+	}
+
+	proposed := s.MergeDiff(d)
+	location := proposed.Attributes["location"]
+	if location == "" || location == config.UnknownVariableValue {
+		return nil
+	}
+
+	// replacing a resource (which is destroyed first) or resizing it frees up the vCPUs it's currently using
+	existing := make([]vmSizeCount, 0)
+	if s != nil && s.ID != "" && azureRMNormalizeLocation(s.Attributes["location"]) == location {
+		existing = sizeCounts(s.Attributes)
+	}
+
+	ctx := client.StopContext
+	skus, err := client.computeCapacity.resourceSkus(ctx, client.compute().resourceSkusClient)
+	if err != nil {
+		log.Printf("[WARN] Unable to validate the Virtual Machine sizes for %s: %+v", info.Id, err)
+		return nil
+	}
+
+	usages, err := client.computeCapacity.usages(ctx, client.compute().usageOpsClient, location)
+	if err != nil {
+		log.Printf("[WARN] Unable to validate the vCPU quota for %s: %+v", info.Id, err)
+		return nil
+	}
+
+	err = client.computeCapacity.planVCPUs(location, info.HumanId(), func(planned map[string]int64) (map[string]int64, error) {
+		return checkComputeCapacity(location, skus, usages, planned, existing, sizeCounts(proposed.Attributes))
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %+v", info.Id, err)
+	}
+
+	return nil
+}
+
+// checkComputeCapacity checks that each of the proposed Virtual Machine sizes is available in the location, and
+// that the additional vCPUs needed (compared to the existing sizes) fit within the remaining quota - once the vCPUs
+// planned for other resources (by the name of the usage) are taken into account. The additional vCPUs needed are
+// returned, by the name of the usage.
+func checkComputeCapacity(location string, skus []compute.ResourceSku, usages []compute.Usage, planned map[string]int64, existing, proposed []vmSizeCount) (map[string]int64, error) {
+	available := make(map[string]compute.ResourceSku)
+	for _, sku := range skus {
+		if sku.ResourceType == nil || !strings.EqualFold(*sku.ResourceType, "virtualMachines") || sku.Name == nil || sku.Locations == nil {
+			continue
+		}
+
+		for _, l := range *sku.Locations {
+			if azureRMNormalizeLocation(l) == location {
+				available[strings.ToLower(*sku.Name)] = sku
+			}
+		}
+	}
+
+	// some clouds (e.g. Azure Stack) don't publish the SKUs available in each location
+	if len(available) == 0 {
+		return nil, nil
+	}
+
+	var errors *multierror.Error
+
+	// the additional vCPUs needed, by the name of the usage (the family of the SKU, and the regional total)
+	required := make(map[string]int64)
+	for _, v := range proposed {
+		if v.size == "" || v.size == config.UnknownVariableValue {
+			continue
+		}
+
+		sku, ok := available[strings.ToLower(v.size)]
+		if !ok {
+			errors = multierror.Append(errors, fmt.Errorf("the Virtual Machine size %q isn't available in %q", v.size, location))
+			continue
+		}
+
+		if reason := resourceSkuRestriction(sku, location); reason != "" {
+			errors = multierror.Append(errors, fmt.Errorf("the Virtual Machine size %q is restricted in %q for this Subscription (%s)", v.size, location, reason))
+			continue
+		}
+
+		addResourceSkuVCPUs(required, sku, int64(v.count))
+	}
+
+	for _, v := range existing {
+		if sku, ok := available[strings.ToLower(v.size)]; ok {
+			addResourceSkuVCPUs(required, sku, -int64(v.count))
+		}
+	}
+
+	for _, usage := range usages {
+		if usage.Name == nil || usage.Name.Value == nil || usage.CurrentValue == nil || usage.Limit == nil {
+			continue
+		}
+
+		needed := required[strings.ToLower(*usage.Name.Value)]
+		if needed <= 0 {
+			continue
+		}
+
+		remaining := *usage.Limit - int64(*usage.CurrentValue)
+		if needed > remaining-planned[strings.ToLower(*usage.Name.Value)] {
+			name := *usage.Name.Value
+			if usage.Name.LocalizedValue != nil {
+				name = *usage.Name.LocalizedValue
+			}
+
+			if others := planned[strings.ToLower(*usage.Name.Value)]; others > 0 {
+				errors = multierror.Append(errors, fmt.Errorf("%d more vCPUs of the quota for %q are needed in %q, but only %d of %d are available - of which %d are needed by other resources", needed, name, location, remaining, *usage.Limit, others))
+				continue
+			}
+
+			errors = multierror.Append(errors, fmt.Errorf("%d more vCPUs of the quota for %q are needed in %q, but only %d of %d are available", needed, name, location, remaining, *usage.Limit))
+		}
+	}
+
+	return required, errors.ErrorOrNil()
+}
+
+// resourceSkuRestriction returns the reason the SKU can't be used in the location, if it's restricted
+func resourceSkuRestriction(sku compute.ResourceSku, location string) string {
+	if sku.Restrictions == nil {
+		return ""
+	}
+
+	for _, restriction := range *sku.Restrictions {
+		if restriction.Type != compute.Location || restriction.Values == nil {
+			continue
+		}
+
+		for _, v := range *restriction.Values {
+			if azureRMNormalizeLocation(v) == location {
+				return string(restriction.ReasonCode)
+			}
+		}
+	}
+
+	return ""
+}
+
+// addResourceSkuVCPUs adds the vCPUs used by the specified number of Virtual Machines of the SKU to both
+// the quota for the family of the SKU, and the regional total
+func addResourceSkuVCPUs(required map[string]int64, sku compute.ResourceSku, count int64) {
+	if sku.Capabilities == nil {
+		return
+	}
+
+	for _, capability := range *sku.Capabilities {
+		if capability.Name == nil || capability.Value == nil || !strings.EqualFold(*capability.Name, "vCPUs") {
+			continue
+		}
+
+		vCPUs, err := strconv.ParseInt(*capability.Value, 10, 64)
+		if err != nil {
+			return
+		}
+
+		if sku.Family != nil {
+			required[strings.ToLower(*sku.Family)] += vCPUs * count
+		}
+		required[totalRegionalVCPUsUsage] += vCPUs * count
+		return
+	}
+}
+
+// nestedVMSizeCounts returns the size and number of Virtual Machines from each of the blocks with the specified
+// name (either a List or a Set) within the attributes of a resource
+func nestedVMSizeCounts(attributes map[string]string, block, sizeKey, countKey string) []vmSizeCount {
+	results := make([]vmSizeCount, 0)
+	for key, size := range attributes {
+		if !strings.HasPrefix(key, block+".") || !strings.HasSuffix(key, "."+sizeKey) {
+			continue
+		}
+
+		index := strings.TrimSuffix(strings.TrimPrefix(key, block+"."), "."+sizeKey)
+		if strings.Contains(index, ".") {
+			continue
+		}
+
+		// the number of Virtual Machines may not be known until another resource has been created
+		count, err := strconv.Atoi(attributes[fmt.Sprintf("%s.%s.%s", block, index, countKey)])
+		if err != nil {
+			continue
+		}
+
+		results = append(results, vmSizeCount{size: size, count: count})
+	}
+
+	return results
+}
+
+// computeCapacityCache holds the Virtual Machine SKUs (which are only retrieved once, since there are thousands
+// of them), the vCPU usage in each location and the additional vCPUs needed by each resource which has been planned
+type computeCapacityCache struct {
+	skusOnce sync.Once
+	skus     []compute.ResourceSku
+	skusErr  error
+
+	usagesLock       sync.Mutex
+	usagesByLocation map[string][]compute.Usage
+
+	// the usage is only retrieved once, as such the vCPUs needed by each resource are kept (by the address of the
+	// resource) until it's planned again - which happens when it's applied, and replaces what it needed previously
+	plannedLock      sync.Mutex
+	plannedResources map[string]plannedVCPUs
+}
+
+// plannedVCPUs are the additional vCPUs needed by a resource in a location, by the name of the usage
+type plannedVCPUs struct {
+	location string
+	required map[string]int64
+}
+
+func (c *computeCapacityCache) resourceSkus(ctx context.Context, client compute.ResourceSkusClient) ([]compute.ResourceSku, error) {
+	c.skusOnce.Do(func() {
+		iterator, err := client.ListComplete(ctx)
+		if err != nil {
+			c.skusErr = fmt.Errorf("Error listing the Resource SKUs: %+v", err)
+			return
+		}
+
+		skus := make([]compute.ResourceSku, 0)
+		for iterator.NotDone() {
+			skus = append(skus, iterator.Value())
+			if err := iterator.Next(); err != nil {
+				c.skusErr = fmt.Errorf("Error listing the Resource SKUs: %+v", err)
+				return
+			}
+		}
+		c.skus = skus
+	})
+
+	return c.skus, c.skusErr
+}
+
+func (c *computeCapacityCache) usages(ctx context.Context, client compute.UsageClient, location string) ([]compute.Usage, error) {
+	c.usagesLock.Lock()
+	defer c.usagesLock.Unlock()
+
+	if usages, ok := c.usagesByLocation[location]; ok {
+		return usages, nil
+	}
+
+	iterator, err := client.ListComplete(ctx, location)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing the Compute usage in %q: %+v", location, err)
+	}
+
+	usages := make([]compute.Usage, 0)
+	for iterator.NotDone() {
+		usages = append(usages, iterator.Value())
+		if err := iterator.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing the Compute usage in %q: %+v", location, err)
+		}
+	}
+
+	if c.usagesByLocation == nil {
+		c.usagesByLocation = make(map[string][]compute.Usage)
+	}
+	c.usagesByLocation[location] = usages
+	return usages, nil
+}
+
+// planVCPUs checks the additional vCPUs needed by the resource in the location using check, which is passed those
+// needed by the other resources planned in the location - and records them when they fit within the quota.
+func (c *computeCapacityCache) planVCPUs(location string, resource string, check func(planned map[string]int64) (map[string]int64, error)) error {
+	c.plannedLock.Lock()
+	defer c.plannedLock.Unlock()
+
+	// only the vCPUs which other resources need in addition are counted, since it isn't known whether the resources
+	// freeing up vCPUs are changed before (or after) this one
+	planned := make(map[string]int64)
+	for address, p := range c.plannedResources {
+		if address == resource || p.location != location {
+			continue
+		}
+
+		for usage, vCPUs := range p.required {
+			if vCPUs > 0 {
+				planned[usage] += vCPUs
+			}
+		}
+	}
+
+	required, err := check(planned)
+	if err != nil {
+		delete(c.plannedResources, resource)
+		return err
+	}
+
+	if c.plannedResources == nil {
+		c.plannedResources = make(map[string]plannedVCPUs)
+	}
+	c.plannedResources[resource] = plannedVCPUs{
+		location: location,
+		required: required,
+	}
+	return nil
+}
+
+// forgetPlannedVCPUs removes the vCPUs needed by a resource which is no longer being created (or resized)
+func (c *computeCapacityCache) forgetPlannedVCPUs(resource string) {
+	c.plannedLock.Lock()
+	defer c.plannedLock.Unlock()
+
+	delete(c.plannedResources, resource)
+}
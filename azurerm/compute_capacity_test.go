@@ -0,0 +1,200 @@
+package azurerm
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestCheckComputeCapacity(t *testing.T) {
+	skus := []compute.ResourceSku{
+		testComputeResourceSku("Standard_F2", "standardFSFamily", "2", nil),
+		testComputeResourceSku("Standard_F4", "standardFSFamily", "4", nil),
+		testComputeResourceSku("Standard_M64s", "standardMSFamily", "64", &[]compute.ResourceSkuRestrictions{
+			{
+				Type:       compute.Location,
+				Values:     &[]string{"westeurope"},
+				ReasonCode: compute.NotAvailableForSubscription,
+			},
+		}),
+	}
+	usages := []compute.Usage{
+		testComputeUsage("cores", "Total Regional vCPUs", 10, 20),
+		testComputeUsage("standardFSFamily", "Standard FS Family vCPUs", 4, 10),
+	}
+
+	cases := []struct {
+		Name     string
+		Location string
+		Planned  map[string]int64
+		Existing []vmSizeCount
+		Proposed []vmSizeCount
+		Errors   []string
+	}{
+		{
+			Name:     "Within Quota",
+			Location: "westeurope",
+			Proposed: []vmSizeCount{{size: "Standard_F2", count: 3}},
+		},
+		{
+			Name:     "Size Is Case Insensitive",
+			Location: "westeurope",
+			Proposed: []vmSizeCount{{size: "standard_f2", count: 1}},
+		},
+		{
+			Name:     "Family Quota Exceeded",
+			Location: "westeurope",
+			Proposed: []vmSizeCount{{size: "Standard_F4", count: 2}},
+			Errors:   []string{`8 more vCPUs of the quota for "Standard FS Family vCPUs" are needed in "westeurope", but only 6 of 10 are available`},
+		},
+		{
+			Name:     "Family Quota Needed By Other Resources",
+			Location: "westeurope",
+			Planned:  map[string]int64{"standardfsfamily": 4},
+			Proposed: []vmSizeCount{{size: "Standard_F4", count: 1}},
+			Errors:   []string{`4 more vCPUs of the quota for "Standard FS Family vCPUs" are needed in "westeurope", but only 6 of 10 are available - of which 4 are needed by other resources`},
+		},
+		{
+			Name:     "Resizing Frees The Existing vCPUs",
+			Location: "westeurope",
+			Existing: []vmSizeCount{{size: "Standard_F2", count: 2}},
+			Proposed: []vmSizeCount{{size: "Standard_F4", count: 2}},
+		},
+		{
+			Name:     "Unknown Size",
+			Location: "westeurope",
+			Proposed: []vmSizeCount{{size: "Standard_Z2", count: 1}},
+			Errors:   []string{`the Virtual Machine size "Standard_Z2" isn't available in "westeurope"`},
+		},
+		{
+			Name:     "Restricted Size",
+			Location: "westeurope",
+			Proposed: []vmSizeCount{{size: "Standard_M64s", count: 1}},
+			Errors:   []string{`the Virtual Machine size "Standard_M64s" is restricted in "westeurope" for this Subscription (NotAvailableForSubscription)`},
+		},
+		{
+			Name:     "No SKUs Published For The Location",
+			Location: "local",
+			Proposed: []vmSizeCount{{size: "Standard_Z2", count: 1}},
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := checkComputeCapacity(tc.Location, skus, usages, tc.Planned, tc.Existing, tc.Proposed)
+		if len(tc.Errors) == 0 {
+			if err != nil {
+				t.Fatalf("Expected no error for %q but got: %+v", tc.Name, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Name)
+		}
+		for _, expected := range tc.Errors {
+			if !strings.Contains(err.Error(), expected) {
+				t.Fatalf("Expected the error for %q to contain %q but got: %+v", tc.Name, expected, err)
+			}
+		}
+	}
+}
+
+func TestComputeCapacityCache_PlanVCPUs(t *testing.T) {
+	skus := []compute.ResourceSku{
+		testComputeResourceSku("Standard_F2", "standardFSFamily", "2", nil),
+	}
+	usages := []compute.Usage{
+		testComputeUsage("cores", "Total Regional vCPUs", 10, 20),
+		testComputeUsage("standardFSFamily", "Standard FS Family vCPUs", 4, 10),
+	}
+
+	cache := computeCapacityCache{}
+	plan := func(location, resource string) error {
+		return cache.planVCPUs(location, resource, func(planned map[string]int64) (map[string]int64, error) {
+			return checkComputeCapacity("westeurope", skus, usages, planned, nil, []vmSizeCount{{size: "Standard_F2", count: 1}})
+		})
+	}
+
+	// each Virtual Machine fits within the remaining quota of 6 vCPUs, but only 3 of them fit together
+	for _, resource := range []string{"azurerm_virtual_machine.test.0", "azurerm_virtual_machine.test.1", "azurerm_virtual_machine.test.2"} {
+		if err := plan("westeurope", resource); err != nil {
+			t.Fatalf("Expected no error planning %s but got: %+v", resource, err)
+		}
+	}
+
+	if err := plan("westeurope", "azurerm_virtual_machine.test.3"); err == nil {
+		t.Fatalf("Expected an error when the Virtual Machines together exceed the quota")
+	}
+
+	// resources are planned again when they're applied, which shouldn't count them twice
+	if err := plan("westeurope", "azurerm_virtual_machine.test.0"); err != nil {
+		t.Fatalf("Expected no error planning a resource again but got: %+v", err)
+	}
+
+	// nor should the vCPUs planned in other locations be counted
+	if err := plan("northeurope", "azurerm_virtual_machine.other"); err != nil {
+		t.Fatalf("Expected no error planning a resource in another location but got: %+v", err)
+	}
+
+	// once a resource is no longer being created, its vCPUs are available to the others
+	cache.forgetPlannedVCPUs("azurerm_virtual_machine.test.2")
+	if err := plan("westeurope", "azurerm_virtual_machine.test.3"); err != nil {
+		t.Fatalf("Expected no error once another resource was no longer planned but got: %+v", err)
+	}
+}
+
+func TestNestedVMSizeCounts(t *testing.T) {
+	attributes := map[string]string{
+		"agent_pool_profile.#":         "2",
+		"agent_pool_profile.0.vm_size": "Standard_F2",
+		"agent_pool_profile.0.count":   "3",
+		"agent_pool_profile.1.vm_size": "Standard_F4",
+		"agent_pool_profile.1.count":   "1",
+		"sku.1234.name":                "Standard_F2",
+	}
+
+	counts := nestedVMSizeCounts(attributes, "agent_pool_profile", "vm_size", "count")
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].size < counts[j].size
+	})
+
+	if len(counts) != 2 {
+		t.Fatalf("Expected 2 sizes but got %d: %+v", len(counts), counts)
+	}
+	if counts[0].size != "Standard_F2" || counts[0].count != 3 {
+		t.Fatalf("Expected 3 x Standard_F2 but got %+v", counts[0])
+	}
+	if counts[1].size != "Standard_F4" || counts[1].count != 1 {
+		t.Fatalf("Expected 1 x Standard_F4 but got %+v", counts[1])
+	}
+}
+
+func testComputeResourceSku(name, family, vCPUs string, restrictions *[]compute.ResourceSkuRestrictions) compute.ResourceSku {
+	return compute.ResourceSku{
+		ResourceType: utils.String("virtualMachines"),
+		Name:         utils.String(name),
+		Family:       utils.String(family),
+		Locations:    &[]string{"westeurope"},
+		Capabilities: &[]compute.ResourceSkuCapabilities{
+			{
+				Name:  utils.String("vCPUs"),
+				Value: utils.String(vCPUs),
+			},
+		},
+		Restrictions: restrictions,
+	}
+}
+
+func testComputeUsage(name, localizedName string, current int32, limit int64) compute.Usage {
+	return compute.Usage{
+		Name: &compute.UsageName{
+			Value:          utils.String(name),
+			LocalizedValue: utils.String(localizedName),
+		},
+		CurrentValue: &current,
+		Limit:        &limit,
+	}
+}
@@ -2,11 +2,14 @@ package azurerm
 
 import (
 	"context"
+	"crypto/rsa"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
-	"net/http/httputil"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -47,6 +50,7 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"golang.org/x/crypto/pkcs12"
 )
 
 // ArmClient contains the handles to all the specific Azure Resource Manager
@@ -58,159 +62,184 @@ type ArmClient struct {
 	usingServicePrincipal    bool
 	environment              azure.Environment
 	skipProviderRegistration bool
+	sender                   autorest.Sender
 
-	StopContext context.Context
+	// tags applied to every resource, unless overridden on the resource
+	defaultTags map[string]string
 
-	cosmosDBClient documentdb.DatabaseAccountsClient
+	// tags which are managed outside of Terraform
+	ignoreTags ignoreTagsConfig
 
-	automationAccountClient    automation.AccountClient
-	automationRunbookClient    automation.RunbookClient
-	automationCredentialClient automation.CredentialClient
-	automationScheduleClient   automation.ScheduleClient
+	// whether creating a resource which already exists takes it over, rather than requiring it to be imported
+	adoptExistingResources bool
 
-	dnsClient   dns.RecordSetsClient
-	zonesClient dns.ZonesClient
+	// whether deleting a Resource Group which contains resources (e.g. those which aren't managed by Terraform) is an error
+	preventResourceGroupDeletionIfContainsResources bool
 
-	containerRegistryClient  containerregistry.RegistriesClient
-	containerServicesClient  containerservice.ContainerServicesClient
-	kubernetesClustersClient containerservice.ManagedClustersClient
-	containerGroupsClient    containerinstance.ContainerGroupsClient
+	// used to build clients for Subscriptions other than the one the Provider is configured for
+	resourceManagerEndpoint string
+	resourceManagerAuth     autorest.Authorizer
+	graphAuth               autorest.Authorizer
+	keyVaultAuth            autorest.Authorizer
 
-	eventGridTopicsClient       eventgrid.TopicsClient
-	eventHubClient              eventhub.EventHubsClient
-	eventHubConsumerGroupClient eventhub.ConsumerGroupsClient
-	eventHubNamespacesClient    eventhub.NamespacesClient
+	// registers the Resource Providers used by the configuration with the Subscription
+	resourceProviders *resourceProviderRegistration
 
-	workspacesClient operationalinsights.WorkspacesClient
+	// long-running operations which were interrupted whilst creating a resource, see waitForCreation
+	pendingOperations pendingOperations
 
-	redisClient               redis.Client
-	redisFirewallClient       redis.FirewallRuleClient
-	redisPatchSchedulesClient redis.PatchSchedulesClient
+	// the locations the Subscription can use, which are loaded the first time a location is validated
+	locationsOnce sync.Once
+	locations     map[string]struct{}
+	locationsErr  error
+
+	// the Virtual Machine SKUs and vCPU quota available to the Subscription, see validateComputeCapacity
+	computeCapacity computeCapacityCache
+
+	StopContext context.Context
+
+	// the clients for each service are built the first time they're used, so that only the tokens
+	// for the audiences which are needed are requested
 
 	// Application Insights
-	appInsightsClient appinsights.ComponentsClient
+	appInsightsOnce  sync.Once
+	appInsightsGroup *appInsightsClients
 
-	// Authentication
-	roleAssignmentsClient   authorization.RoleAssignmentsClient
-	roleDefinitionsClient   authorization.RoleDefinitionsClient
-	servicePrincipalsClient graphrbac.ServicePrincipalsClient
+	// Automation
+	automationOnce  sync.Once
+	automationGroup *automationClients
+
+	// Authorization
+	authorizationOnce  sync.Once
+	authorizationGroup *authorizationClients
 
 	// CDN
-	cdnProfilesClient  cdn.ProfilesClient
-	cdnEndpointsClient cdn.EndpointsClient
+	cdnOnce  sync.Once
+	cdnGroup *cdnClients
 
 	// Compute
-	availSetClient         compute.AvailabilitySetsClient
-	diskClient             compute.DisksClient
-	imageClient            compute.ImagesClient
-	snapshotsClient        compute.SnapshotsClient
-	usageOpsClient         compute.UsageClient
-	vmExtensionImageClient compute.VirtualMachineExtensionImagesClient
-	vmExtensionClient      compute.VirtualMachineExtensionsClient
-	vmScaleSetClient       compute.VirtualMachineScaleSetsClient
-	vmImageClient          compute.VirtualMachineImagesClient
-	vmClient               compute.VirtualMachinesClient
+	computeOnce  sync.Once
+	computeGroup *computeClients
+
+	// Container Instance
+	containerInstanceOnce  sync.Once
+	containerInstanceGroup *containerInstanceClients
+
+	// Container Registry
+	containerRegistryOnce  sync.Once
+	containerRegistryGroup *containerRegistryClients
+
+	// Container Services
+	containerServicesOnce  sync.Once
+	containerServicesGroup *containerServicesClients
+
+	// CosmosDB
+	cosmosDBOnce  sync.Once
+	cosmosDBGroup *cosmosDBClients
 
 	// Databases
-	mysqlConfigurationsClient      mysql.ConfigurationsClient
-	mysqlDatabasesClient           mysql.DatabasesClient
-	mysqlFirewallRulesClient       mysql.FirewallRulesClient
-	mysqlServersClient             mysql.ServersClient
-	postgresqlConfigurationsClient postgresql.ConfigurationsClient
-	postgresqlDatabasesClient      postgresql.DatabasesClient
-	postgresqlFirewallRulesClient  postgresql.FirewallRulesClient
-	postgresqlServersClient        postgresql.ServersClient
-	sqlDatabasesClient             sql.DatabasesClient
-	sqlElasticPoolsClient          sql.ElasticPoolsClient
-	sqlFirewallRulesClient         sql.FirewallRulesClient
-	sqlServersClient               sql.ServersClient
+	databasesOnce  sync.Once
+	databasesGroup *databasesClients
+
+	// DNS
+	dnsOnce  sync.Once
+	dnsGroup *dnsClients
+
+	// EventGrid
+	eventGridOnce  sync.Once
+	eventGridGroup *eventGridClients
+
+	// EventHub
+	eventHubOnce  sync.Once
+	eventHubGroup *eventHubClients
 
 	// KeyVault
-	keyVaultClient           keyvault.VaultsClient
-	keyVaultManagementClient keyVault.BaseClient
+	keyVaultOnce  sync.Once
+	keyVaultGroup *keyVaultClients
 
 	// Monitor
-	monitorAlertRulesClient insights.AlertRulesClient
+	monitorOnce  sync.Once
+	monitorGroup *monitorClients
 
 	// Networking
-	applicationGatewayClient     network.ApplicationGatewaysClient
-	expressRouteCircuitClient    network.ExpressRouteCircuitsClient
-	ifaceClient                  network.InterfacesClient
-	loadBalancerClient           network.LoadBalancersClient
-	localNetConnClient           network.LocalNetworkGatewaysClient
-	publicIPClient               network.PublicIPAddressesClient
-	routesClient                 network.RoutesClient
-	routeTablesClient            network.RouteTablesClient
-	secGroupClient               network.SecurityGroupsClient
-	secRuleClient                network.SecurityRulesClient
-	subnetClient                 network.SubnetsClient
-	netUsageClient               network.UsagesClient
-	vnetGatewayConnectionsClient network.VirtualNetworkGatewayConnectionsClient
-	vnetGatewayClient            network.VirtualNetworkGatewaysClient
-	vnetClient                   network.VirtualNetworksClient
-	vnetPeeringsClient           network.VirtualNetworkPeeringsClient
-	watcherClient                network.WatchersClient
+	networkingOnce  sync.Once
+	networkingGroup *networkingClients
+
+	// Operational Insights
+	operationalInsightsOnce  sync.Once
+	operationalInsightsGroup *operationalInsightsClients
+
+	// Redis
+	redisOnce  sync.Once
+	redisGroup *redisClients
 
 	// Resources
-	managementLocksClient locks.ManagementLocksClient
-	deploymentsClient     resources.DeploymentsClient
-	providersClient       resources.ProvidersClient
-	resourcesClient       resources.Client
-	resourceGroupsClient  resources.GroupsClient
-	subscriptionsClient   subscriptions.Client
+	resourcesOnce  sync.Once
+	resourcesGroup *resourcesClients
 
 	// Search
-	searchServicesClient search.ServicesClient
+	searchOnce  sync.Once
+	searchGroup *searchClients
 
 	// ServiceBus
-	serviceBusQueuesClient        servicebus.QueuesClient
-	serviceBusNamespacesClient    servicebus.NamespacesClient
-	serviceBusTopicsClient        servicebus.TopicsClient
-	serviceBusSubscriptionsClient servicebus.SubscriptionsClient
+	serviceBusOnce  sync.Once
+	serviceBusGroup *serviceBusClients
 
 	// Storage
-	storageServiceClient storage.AccountsClient
-	storageUsageClient   storage.UsageClient
+	storageOnce  sync.Once
+	storageGroup *storageClients
 
 	// Traffic Manager
-	trafficManagerProfilesClient  trafficmanager.ProfilesClient
-	trafficManagerEndpointsClient trafficmanager.EndpointsClient
+	trafficManagerOnce  sync.Once
+	trafficManagerGroup *trafficManagerClients
 
 	// Web
-	appServicePlansClient web.AppServicePlansClient
-	appServicesClient     web.AppsClient
+	webOnce  sync.Once
+	webGroup *webClients
 }
 
 func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Authorizer) {
 	setUserAgent(client)
 	client.Authorizer = auth
-	client.Sender = autorest.CreateSender(withRequestLogging())
+	client.Sender = c.sender
 	client.SkipResourceProviderRegistration = c.skipProviderRegistration
-	client.PollingDuration = 60 * time.Minute
+
+	// the timeouts defined on each resource are passed to the SDK through the context's deadline,
+	// as such this is only an upper bound to ensure that polling always ends eventually
+	client.PollingDuration = 24 * time.Hour
 }
 
-func withRequestLogging() autorest.SendDecorator {
+// pollingClient returns a client which can poll the status of a long-running operation in Resource Manager
+func (c *ArmClient) pollingClient() autorest.Client {
+	client := autorest.NewClientWithUserAgent("")
+	c.configureClient(&client, c.resourceManagerAuth)
+	return client
+}
+
+// withRequestLogging returns a SendDecorator which logs each request and response in wire format, with any
+// credentials and secrets redacted. Bodies are only logged when logBodies is true.
+func withRequestLogging(logBodies bool) autorest.SendDecorator {
 	return func(s autorest.Sender) autorest.Sender {
 		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
 			// dump request to wire format
-			if dump, err := httputil.DumpRequestOut(r, true); err == nil {
+			if dump, err := dumpRequest(r, logBodies); err == nil {
 				log.Printf("[DEBUG] AzureRM Request: \n%s\n", dump)
 			} else {
 				// fallback to basic message
-				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, r.URL)
+				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, redactUrl(r.URL))
 			}
 
 			resp, err := s.Do(r)
 			if resp != nil {
 				// dump response to wire format
-				if dump, err := httputil.DumpResponse(resp, true); err == nil {
-					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, dump)
+				if dump, err := dumpResponse(r, resp, logBodies); err == nil {
+					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", redactUrl(r.URL), dump)
 				} else {
 					// fallback to basic message
-					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, r.URL)
+					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, redactUrl(r.URL))
 				}
 			} else {
-				log.Printf("[DEBUG] Request to %s completed with no response", r.URL)
+				log.Printf("[DEBUG] Request to %s completed with no response", redactUrl(r.URL))
 			}
 			return resp, err
 		})
@@ -233,7 +262,32 @@ func setUserAgent(client *autorest.Client) {
 	}
 }
 
-func getAuthorizationToken(c *authentication.Config, oauthConfig *adal.OAuthConfig, endpoint string) (*autorest.BearerAuthorizer, error) {
+func getAuthorizationToken(c *authentication.Config, env azure.Environment, oauthConfig *adal.OAuthConfig, endpoint string) (*autorest.BearerAuthorizer, error) {
+	if c.UseMsi {
+		spt, err := adal.NewServicePrincipalTokenFromMSI(c.MsiEndpoint, endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		auth := autorest.NewBearerAuthorizer(spt)
+		return auth, nil
+	}
+
+	if c.ClientCertPath != "" {
+		certificate, privateKey, err := decodeClientCertificate(c.ClientCertPath, c.ClientCertPassword)
+		if err != nil {
+			return nil, err
+		}
+
+		spt, err := adal.NewServicePrincipalTokenFromCertificate(*oauthConfig, c.ClientID, certificate, privateKey, endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		auth := autorest.NewBearerAuthorizer(spt)
+		return auth, nil
+	}
+
 	useServicePrincipal := c.ClientSecret != ""
 
 	if useServicePrincipal {
@@ -254,7 +308,8 @@ func getAuthorizationToken(c *authentication.Config, oauthConfig *adal.OAuthConf
 		}
 	}
 
-	spt, err := adal.NewServicePrincipalTokenFromManualToken(*oauthConfig, c.ClientID, endpoint, *c.AccessToken)
+	// the Refresh Token from the Azure CLI means the token is refreshed automatically when it expires
+	spt, err := adal.NewServicePrincipalTokenFromManualToken(*oauthConfig, c.ClientID, endpoint, c.AccessTokenForResource(env, endpoint))
 	if err != nil {
 		return nil, err
 	}
@@ -263,18 +318,74 @@ func getAuthorizationToken(c *authentication.Config, oauthConfig *adal.OAuthConf
 	return auth, nil
 }
 
+// lazyAuthorizer is an Authorizer which is only built the first time a request needs to be authorized,
+// such that a token is only requested for an audience when a client for it is used. Building it again is
+// attempted by the next request if it fails (e.g. as the MSI endpoint is briefly unavailable).
+type lazyAuthorizer struct {
+	build func() (autorest.Authorizer, error)
+
+	lock       sync.Mutex
+	authorizer autorest.Authorizer
+}
+
+func (a *lazyAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			authorizer, err := a.get()
+			if err != nil {
+				return r, err
+			}
+
+			return authorizer.WithAuthorization()(p).Prepare(r)
+		})
+	}
+}
+
+func (a *lazyAuthorizer) get() (autorest.Authorizer, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.authorizer != nil {
+		return a.authorizer, nil
+	}
+
+	authorizer, err := a.build()
+	if err != nil {
+		return nil, err
+	}
+
+	a.authorizer = authorizer
+	return authorizer, nil
+}
+
+// decodeClientCertificate reads the PFX (PKCS#12) file at the specified path and
+// returns the Certificate and RSA Private Key contained within it.
+func decodeClientCertificate(path string, password string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error reading the Client Certificate at %q: %+v", path, err)
+	}
+
+	privateKey, certificate, err := pkcs12.Decode(data, password)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error decoding the Client Certificate at %q: %+v", path, err)
+	}
+
+	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("The Client Certificate at %q doesn't contain an RSA Private Key", path)
+	}
+
+	return certificate, rsaPrivateKey, nil
+}
+
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
 func getArmClient(c *authentication.Config) (*ArmClient, error) {
-	// detect cloud from environment
-	env, envErr := azure.EnvironmentFromName(c.Environment)
-	if envErr != nil {
-		// try again with wrapped value to support readable values like german instead of AZUREGERMANCLOUD
-		wrapped := fmt.Sprintf("AZURE%sCLOUD", c.Environment)
-		var innerErr error
-		if env, innerErr = azure.EnvironmentFromName(wrapped); innerErr != nil {
-			return nil, envErr
-		}
+	// detect cloud from the environment, or the metadata published by a custom ARM Endpoint (e.g. Azure Stack)
+	env, err := c.DetermineEnvironment()
+	if err != nil {
+		return nil, err
 	}
 
 	// client declarations:
@@ -282,8 +393,8 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		clientId:                 c.ClientID,
 		tenantId:                 c.TenantID,
 		subscriptionId:           c.SubscriptionID,
-		environment:              env,
-		usingServicePrincipal:    c.ClientSecret != "",
+		environment:              *env,
+		usingServicePrincipal:    c.ClientSecret != "" || c.ClientCertPath != "",
 		skipProviderRegistration: c.SkipProviderRegistration,
 	}
 
@@ -297,25 +408,73 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
 	}
 
-	sender := autorest.CreateSender(withRequestLogging())
+	// when running the acceptance tests offline, requests are recorded to (or replayed from) a cassette
+	cassette := currentCassette()
+	decorators := make([]autorest.SendDecorator, 0)
+	if cassette != nil {
+		decorators = append(decorators, withRecording(cassette, env.ResourceManagerEndpoint, c.SubscriptionID, c.TenantID))
+	}
+
+	// each attempt is logged, with the retries wrapping the logging
+	decorators = append(decorators, withRequestLogging(!c.DisableHttpBodyLogging), withThrottlingRetries(retryOptions{
+		maxRetries: c.MaxRetries,
+		baseDelay:  retryBaseDelay,
+		maxDelay:   c.MaxRetryDelay,
+	}), client.withIgnoredTagsPreserved())
+	sender := autorest.CreateSender(decorators...)
+	client.sender = sender
 
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
-	auth, err := getAuthorizationToken(c, oauthConfig, endpoint)
+	tokenAudience := endpoint
+	if c.ArmEndpoint != "" {
+		// custom clouds such as Azure Stack issue tokens for the audience published in the metadata
+		tokenAudience = env.ServiceManagementEndpoint
+	}
+	armAuth, err := getAuthorizationToken(c, *env, oauthConfig, tokenAudience)
 	if err != nil {
 		return nil, err
 	}
 
+	var auth autorest.Authorizer = armAuth
+	if len(c.AuxiliaryTenantIDs) > 0 {
+		auxiliaryTokens, err := getAuxiliaryTenantTokens(c, env.ActiveDirectoryEndpoint, tokenAudience)
+		if err != nil {
+			return nil, err
+		}
+
+		auth = auxiliaryTenantAuthorizer{
+			primary:     armAuth,
+			auxiliaries: auxiliaryTokens,
+		}
+	}
+	if cassette != nil && cassette.replaying() {
+		// there's no need to obtain tokens when replaying requests
+		auth = autorest.NullAuthorizer{}
+	}
+
+	client.resourceManagerEndpoint = endpoint
+	client.resourceManagerAuth = auth
+
+	// Resource Providers are registered the first time a request is made to them - which uses a Sender
+	// without this behaviour, since registering a Resource Provider is itself a request to it
+	providersClient := resources.NewProvidersClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&providersClient.Client, auth)
+	client.resourceProviders = newResourceProviderRegistration(providersClient, c.SubscriptionID)
+	if !c.SkipProviderRegistration && !c.SkipCredentialsValidation {
+		client.sender = autorest.DecorateSender(sender, withResourceProviderRegistration(client.resourceProviders))
+	}
+
 	// Graph Endpoints
-	graphEndpoint := env.GraphEndpoint
-	graphAuth, err := getAuthorizationToken(c, oauthConfig, graphEndpoint)
-	if err != nil {
-		return nil, err
+	client.graphAuth = &lazyAuthorizer{
+		build: func() (autorest.Authorizer, error) {
+			return getAuthorizationToken(c, *env, oauthConfig, env.GraphEndpoint)
+		},
 	}
 
 	// Key Vault Endpoints
-	keyVaultAuth := autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
-		keyVaultSpt, err := getAuthorizationToken(c, oauthConfig, resource)
+	client.keyVaultAuth = autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
+		keyVaultSpt, err := getAuthorizationToken(c, *env, oauthConfig, resource)
 		if err != nil {
 			return nil, err
 		}
@@ -323,498 +482,975 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		return keyVaultSpt, nil
 	})
 
-	csc := containerservice.NewContainerServicesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&csc.Client)
-	csc.Authorizer = auth
-	csc.Sender = sender
-	csc.SkipResourceProviderRegistration = c.SkipProviderRegistration
-	client.containerServicesClient = csc
-
-	client.registerAppInsightsClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerAutomationClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerAuthentication(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth, sender)
-	client.registerCDNClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerComputeClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerContainerServicesClients(endpoint, c.SubscriptionID, auth)
-	client.registerCosmosDBClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerContainerInstanceClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerContainerRegistryClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDatabases(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDNSClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerEventGridClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerEventHubClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerKeyVaultClients(endpoint, c.SubscriptionID, auth, keyVaultAuth, sender)
-	client.registerMonitorClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerNetworkingClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerOperationalInsightsClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerRedisClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerResourcesClients(endpoint, c.SubscriptionID, auth)
-	client.registerSearchClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceBusClients(endpoint, c.SubscriptionID, auth)
-	client.registerStorageClients(endpoint, c.SubscriptionID, auth)
-	client.registerTrafficManagerClients(endpoint, c.SubscriptionID, auth)
-	client.registerWebClients(endpoint, c.SubscriptionID, auth)
+	if cassette != nil && cassette.replaying() {
+		client.graphAuth = autorest.NullAuthorizer{}
+		client.keyVaultAuth = autorest.NullAuthorizer{}
+	}
 
 	return &client, nil
 }
 
-func (c *ArmClient) registerAppInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+// appInsightsClients contains the Application Insights clients, which are built the first time they're used.
+type appInsightsClients struct {
+	appInsightsClient appinsights.ComponentsClient
+}
+
+func (c *ArmClient) appInsights() *appInsightsClients {
+	c.appInsightsOnce.Do(func() {
+		c.appInsightsGroup = c.newAppInsightsClients()
+	})
+	return c.appInsightsGroup
+}
+
+func (c *ArmClient) newAppInsightsClients() *appInsightsClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &appInsightsClients{}
+
 	ai := appinsights.NewComponentsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&ai.Client)
-	ai.Authorizer = auth
-	ai.Sender = sender
-	ai.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.appInsightsClient = ai
+	c.configureClient(&ai.Client, auth)
+	clients.appInsightsClient = ai
+
+	return clients
+}
+
+// automationClients contains the Automation clients, which are built the first time they're used.
+type automationClients struct {
+	automationAccountClient    automation.AccountClient
+	automationCredentialClient automation.CredentialClient
+	automationRunbookClient    automation.RunbookClient
+	automationScheduleClient   automation.ScheduleClient
 }
 
-func (c *ArmClient) registerAutomationClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+func (c *ArmClient) automation() *automationClients {
+	c.automationOnce.Do(func() {
+		c.automationGroup = c.newAutomationClients()
+	})
+	return c.automationGroup
+}
+
+func (c *ArmClient) newAutomationClients() *automationClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &automationClients{}
+
 	accountClient := automation.NewAccountClientWithBaseURI(endpoint, subscriptionId, "")
-	setUserAgent(&accountClient.Client)
-	accountClient.Authorizer = auth
-	accountClient.Sender = sender
-	accountClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.automationAccountClient = accountClient
+	c.configureClient(&accountClient.Client, auth)
+	clients.automationAccountClient = accountClient
 
 	credentialClient := automation.NewCredentialClientWithBaseURI(endpoint, subscriptionId, "")
-	setUserAgent(&credentialClient.Client)
-	credentialClient.Authorizer = auth
-	credentialClient.Sender = sender
-	credentialClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.automationCredentialClient = credentialClient
+	c.configureClient(&credentialClient.Client, auth)
+	clients.automationCredentialClient = credentialClient
 
 	runbookClient := automation.NewRunbookClientWithBaseURI(endpoint, subscriptionId, "")
-	setUserAgent(&runbookClient.Client)
-	runbookClient.Authorizer = auth
-	runbookClient.Sender = sender
-	runbookClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.automationRunbookClient = runbookClient
+	c.configureClient(&runbookClient.Client, auth)
+	clients.automationRunbookClient = runbookClient
 
 	scheduleClient := automation.NewScheduleClientWithBaseURI(endpoint, subscriptionId, "")
-	setUserAgent(&scheduleClient.Client)
-	scheduleClient.Authorizer = auth
-	scheduleClient.Sender = sender
-	scheduleClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.automationScheduleClient = scheduleClient
+	c.configureClient(&scheduleClient.Client, auth)
+	clients.automationScheduleClient = scheduleClient
+
+	return clients
+}
+
+// authorizationClients contains the Authorization clients, which are built the first time they're used.
+type authorizationClients struct {
+	roleAssignmentsClient   authorization.RoleAssignmentsClient
+	roleDefinitionsClient   authorization.RoleDefinitionsClient
+	servicePrincipalsClient graphrbac.ServicePrincipalsClient
+}
+
+func (c *ArmClient) authorization() *authorizationClients {
+	c.authorizationOnce.Do(func() {
+		c.authorizationGroup = c.newAuthorizationClients()
+	})
+	return c.authorizationGroup
 }
 
-func (c *ArmClient) registerAuthentication(endpoint, graphEndpoint, subscriptionId, tenantId string, auth, graphAuth autorest.Authorizer, sender autorest.Sender) {
+func (c *ArmClient) newAuthorizationClients() *authorizationClients {
+	endpoint := c.resourceManagerEndpoint
+	graphEndpoint := c.environment.GraphEndpoint
+	subscriptionId := c.subscriptionId
+	tenantId := c.tenantId
+	auth := c.resourceManagerAuth
+	graphAuth := c.graphAuth
+	clients := &authorizationClients{}
+
 	assignmentsClient := authorization.NewRoleAssignmentsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&assignmentsClient.Client)
-	assignmentsClient.Authorizer = auth
-	assignmentsClient.Sender = sender
-	assignmentsClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.roleAssignmentsClient = assignmentsClient
+	c.configureClient(&assignmentsClient.Client, auth)
+	clients.roleAssignmentsClient = assignmentsClient
 
 	definitionsClient := authorization.NewRoleDefinitionsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&definitionsClient.Client)
-	definitionsClient.Authorizer = auth
-	definitionsClient.Sender = sender
-	definitionsClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.roleDefinitionsClient = definitionsClient
+	c.configureClient(&definitionsClient.Client, auth)
+	clients.roleDefinitionsClient = definitionsClient
 
 	servicePrincipalsClient := graphrbac.NewServicePrincipalsClientWithBaseURI(graphEndpoint, tenantId)
-	setUserAgent(&servicePrincipalsClient.Client)
-	servicePrincipalsClient.Authorizer = graphAuth
-	servicePrincipalsClient.Sender = sender
-	servicePrincipalsClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.servicePrincipalsClient = servicePrincipalsClient
+	c.configureClient(&servicePrincipalsClient.Client, graphAuth)
+	clients.servicePrincipalsClient = servicePrincipalsClient
+
+	return clients
 }
 
-func (c *ArmClient) registerCDNClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+// cdnClients contains the CDN clients, which are built the first time they're used.
+type cdnClients struct {
+	cdnEndpointsClient cdn.EndpointsClient
+	cdnProfilesClient  cdn.ProfilesClient
+}
+
+func (c *ArmClient) cdn() *cdnClients {
+	c.cdnOnce.Do(func() {
+		c.cdnGroup = c.newCdnClients()
+	})
+	return c.cdnGroup
+}
+
+func (c *ArmClient) newCdnClients() *cdnClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &cdnClients{}
+
 	endpointsClient := cdn.NewEndpointsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&endpointsClient.Client)
-	endpointsClient.Authorizer = auth
-	endpointsClient.Sender = sender
-	endpointsClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.cdnEndpointsClient = endpointsClient
+	c.configureClient(&endpointsClient.Client, auth)
+	clients.cdnEndpointsClient = endpointsClient
 
 	profilesClient := cdn.NewProfilesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&profilesClient.Client)
-	profilesClient.Authorizer = auth
-	profilesClient.Sender = sender
-	profilesClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.cdnProfilesClient = profilesClient
+	c.configureClient(&profilesClient.Client, auth)
+	clients.cdnProfilesClient = profilesClient
+
+	return clients
 }
 
-func (c *ArmClient) registerCosmosDBClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
-	cdb := documentdb.NewDatabaseAccountsClientWithBaseURI(endpoint, subscriptionId, "", "", "", "", "")
-	c.configureClient(&cdb.Client, auth)
-	c.cosmosDBClient = cdb
+// computeClients contains the Compute clients, which are built the first time they're used.
+type computeClients struct {
+	availSetClient         compute.AvailabilitySetsClient
+	diskClient             compute.DisksClient
+	imageClient            compute.ImagesClient
+	resourceSkusClient     compute.ResourceSkusClient
+	snapshotsClient        compute.SnapshotsClient
+	usageOpsClient         compute.UsageClient
+	vmExtensionImageClient compute.VirtualMachineExtensionImagesClient
+	vmExtensionClient      compute.VirtualMachineExtensionsClient
+	vmImageClient          compute.VirtualMachineImagesClient
+	vmScaleSetClient       compute.VirtualMachineScaleSetsClient
+	vmClient               compute.VirtualMachinesClient
 }
 
-func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+func (c *ArmClient) compute() *computeClients {
+	c.computeOnce.Do(func() {
+		c.computeGroup = c.newComputeClients()
+	})
+	return c.computeGroup
+}
+
+func (c *ArmClient) newComputeClients() *computeClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &computeClients{}
+
 	availabilitySetsClient := compute.NewAvailabilitySetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&availabilitySetsClient.Client, auth)
-	c.availSetClient = availabilitySetsClient
+	clients.availSetClient = availabilitySetsClient
 
 	diskClient := compute.NewDisksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&diskClient.Client, auth)
-	c.diskClient = diskClient
+	clients.diskClient = diskClient
 
 	imagesClient := compute.NewImagesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&imagesClient.Client, auth)
-	c.imageClient = imagesClient
+	clients.imageClient = imagesClient
+
+	resourceSkusClient := compute.NewResourceSkusClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&resourceSkusClient.Client, auth)
+	clients.resourceSkusClient = resourceSkusClient
 
 	snapshotsClient := compute.NewSnapshotsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&snapshotsClient.Client, auth)
-	c.snapshotsClient = snapshotsClient
+	clients.snapshotsClient = snapshotsClient
 
 	usageClient := compute.NewUsageClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&usageClient.Client, auth)
-	c.usageOpsClient = usageClient
+	clients.usageOpsClient = usageClient
 
 	extensionImagesClient := compute.NewVirtualMachineExtensionImagesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&extensionImagesClient.Client, auth)
-	c.vmExtensionImageClient = extensionImagesClient
+	clients.vmExtensionImageClient = extensionImagesClient
 
 	extensionsClient := compute.NewVirtualMachineExtensionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&extensionsClient.Client, auth)
-	c.vmExtensionClient = extensionsClient
+	clients.vmExtensionClient = extensionsClient
 
 	virtualMachineImagesClient := compute.NewVirtualMachineImagesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachineImagesClient.Client, auth)
-	c.vmImageClient = virtualMachineImagesClient
+	clients.vmImageClient = virtualMachineImagesClient
 
 	scaleSetsClient := compute.NewVirtualMachineScaleSetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&scaleSetsClient.Client, auth)
-	c.vmScaleSetClient = scaleSetsClient
+	clients.vmScaleSetClient = scaleSetsClient
 
 	virtualMachinesClient := compute.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachinesClient.Client, auth)
-	c.vmClient = virtualMachinesClient
+	clients.vmClient = virtualMachinesClient
+
+	return clients
+}
+
+// containerInstanceClients contains the Container Instance clients, which are built the first time they're used.
+type containerInstanceClients struct {
+	containerGroupsClient containerinstance.ContainerGroupsClient
 }
 
-func (c *ArmClient) registerContainerInstanceClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+func (c *ArmClient) containerInstance() *containerInstanceClients {
+	c.containerInstanceOnce.Do(func() {
+		c.containerInstanceGroup = c.newContainerInstanceClients()
+	})
+	return c.containerInstanceGroup
+}
+
+func (c *ArmClient) newContainerInstanceClients() *containerInstanceClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &containerInstanceClients{}
+
 	cgc := containerinstance.NewContainerGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&cgc.Client, auth)
-	c.containerGroupsClient = cgc
+	clients.containerGroupsClient = cgc
+
+	return clients
+}
+
+// containerRegistryClients contains the Container Registry clients, which are built the first time they're used.
+type containerRegistryClients struct {
+	containerRegistryClient containerregistry.RegistriesClient
+}
+
+func (c *ArmClient) containerRegistry() *containerRegistryClients {
+	c.containerRegistryOnce.Do(func() {
+		c.containerRegistryGroup = c.newContainerRegistryClients()
+	})
+	return c.containerRegistryGroup
 }
 
-func (c *ArmClient) registerContainerRegistryClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+func (c *ArmClient) newContainerRegistryClients() *containerRegistryClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &containerRegistryClients{}
+
 	crc := containerregistry.NewRegistriesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&crc.Client, auth)
-	c.containerRegistryClient = crc
+	clients.containerRegistryClient = crc
+
+	return clients
+}
+
+// containerServicesClients contains the Container Services clients, which are built the first time they're used.
+type containerServicesClients struct {
+	containerServicesClient  containerservice.ContainerServicesClient
+	kubernetesClustersClient containerservice.ManagedClustersClient
+}
+
+func (c *ArmClient) containerServices() *containerServicesClients {
+	c.containerServicesOnce.Do(func() {
+		c.containerServicesGroup = c.newContainerServicesClients()
+	})
+	return c.containerServicesGroup
 }
 
-func (c *ArmClient) registerContainerServicesClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) newContainerServicesClients() *containerServicesClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &containerServicesClients{}
+
 	// ACS
 	containerServicesClient := containerservice.NewContainerServicesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&containerServicesClient.Client, auth)
-	c.containerServicesClient = containerServicesClient
+	clients.containerServicesClient = containerServicesClient
 
 	// AKS
 	kubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&kubernetesClustersClient.Client, auth)
-	c.kubernetesClustersClient = kubernetesClustersClient
+	clients.kubernetesClustersClient = kubernetesClustersClient
+
+	return clients
+}
+
+// cosmosDBClients contains the CosmosDB clients, which are built the first time they're used.
+type cosmosDBClients struct {
+	cosmosDBClient documentdb.DatabaseAccountsClient
+}
+
+func (c *ArmClient) cosmosDB() *cosmosDBClients {
+	c.cosmosDBOnce.Do(func() {
+		c.cosmosDBGroup = c.newCosmosDBClients()
+	})
+	return c.cosmosDBGroup
+}
+
+func (c *ArmClient) newCosmosDBClients() *cosmosDBClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &cosmosDBClients{}
+
+	cdb := documentdb.NewDatabaseAccountsClientWithBaseURI(endpoint, subscriptionId, "", "", "", "", "")
+	c.configureClient(&cdb.Client, auth)
+	clients.cosmosDBClient = cdb
+
+	return clients
+}
+
+// databasesClients contains the Databases clients, which are built the first time they're used.
+type databasesClients struct {
+	mysqlConfigurationsClient        mysql.ConfigurationsClient
+	mysqlDatabasesClient             mysql.DatabasesClient
+	mysqlFirewallRulesClient         mysql.FirewallRulesClient
+	mysqlNameAvailabilityClient      mysql.CheckNameAvailabilityClient
+	mysqlServersClient               mysql.ServersClient
+	postgresqlConfigurationsClient   postgresql.ConfigurationsClient
+	postgresqlDatabasesClient        postgresql.DatabasesClient
+	postgresqlFirewallRulesClient    postgresql.FirewallRulesClient
+	postgresqlNameAvailabilityClient postgresql.CheckNameAvailabilityClient
+	postgresqlServersClient          postgresql.ServersClient
+	sqlDatabasesClient               sql.DatabasesClient
+	sqlFirewallRulesClient           sql.FirewallRulesClient
+	sqlElasticPoolsClient            sql.ElasticPoolsClient
+	sqlServersClient                 sql.ServersClient
 }
 
-func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+func (c *ArmClient) databases() *databasesClients {
+	c.databasesOnce.Do(func() {
+		c.databasesGroup = c.newDatabasesClients()
+	})
+	return c.databasesGroup
+}
+
+func (c *ArmClient) newDatabasesClients() *databasesClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &databasesClients{}
+
 	// MySQL
 	mysqlConfigClient := mysql.NewConfigurationsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&mysqlConfigClient.Client)
-	mysqlConfigClient.Authorizer = auth
-	mysqlConfigClient.Sender = sender
-	mysqlConfigClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.mysqlConfigurationsClient = mysqlConfigClient
+	c.configureClient(&mysqlConfigClient.Client, auth)
+	clients.mysqlConfigurationsClient = mysqlConfigClient
 
 	mysqlDBClient := mysql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&mysqlDBClient.Client)
-	mysqlDBClient.Authorizer = auth
-	mysqlDBClient.Sender = sender
-	mysqlDBClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.mysqlDatabasesClient = mysqlDBClient
+	c.configureClient(&mysqlDBClient.Client, auth)
+	clients.mysqlDatabasesClient = mysqlDBClient
 
 	mysqlFWClient := mysql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&mysqlFWClient.Client)
-	mysqlFWClient.Authorizer = auth
-	mysqlFWClient.Sender = sender
-	mysqlFWClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.mysqlFirewallRulesClient = mysqlFWClient
+	c.configureClient(&mysqlFWClient.Client, auth)
+	clients.mysqlFirewallRulesClient = mysqlFWClient
+
+	mysqlNameAvailabilityClient := mysql.NewCheckNameAvailabilityClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&mysqlNameAvailabilityClient.Client, auth)
+	clients.mysqlNameAvailabilityClient = mysqlNameAvailabilityClient
 
 	mysqlServersClient := mysql.NewServersClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&mysqlServersClient.Client)
-	mysqlServersClient.Authorizer = auth
-	mysqlServersClient.Sender = sender
-	mysqlServersClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.mysqlServersClient = mysqlServersClient
+	c.configureClient(&mysqlServersClient.Client, auth)
+	clients.mysqlServersClient = mysqlServersClient
 
 	// PostgreSQL
 	postgresqlConfigClient := postgresql.NewConfigurationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlConfigClient.Client, auth)
-	c.postgresqlConfigurationsClient = postgresqlConfigClient
+	clients.postgresqlConfigurationsClient = postgresqlConfigClient
 
 	postgresqlDBClient := postgresql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlDBClient.Client, auth)
-	c.postgresqlDatabasesClient = postgresqlDBClient
+	clients.postgresqlDatabasesClient = postgresqlDBClient
 
 	postgresqlFWClient := postgresql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlFWClient.Client, auth)
-	c.postgresqlFirewallRulesClient = postgresqlFWClient
+	clients.postgresqlFirewallRulesClient = postgresqlFWClient
+
+	postgresqlNameAvailabilityClient := postgresql.NewCheckNameAvailabilityClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&postgresqlNameAvailabilityClient.Client, auth)
+	clients.postgresqlNameAvailabilityClient = postgresqlNameAvailabilityClient
 
 	postgresqlSrvClient := postgresql.NewServersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&postgresqlSrvClient.Client, auth)
-	c.postgresqlServersClient = postgresqlSrvClient
+	clients.postgresqlServersClient = postgresqlSrvClient
 
 	// SQL Azure
 	sqlDBClient := sql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&sqlDBClient.Client)
-	sqlDBClient.Authorizer = auth
-	sqlDBClient.Sender = sender
-	sqlDBClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.sqlDatabasesClient = sqlDBClient
+	c.configureClient(&sqlDBClient.Client, auth)
+	clients.sqlDatabasesClient = sqlDBClient
 
 	sqlFWClient := sql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&sqlFWClient.Client)
-	sqlFWClient.Authorizer = auth
-	sqlFWClient.Sender = sender
-	sqlFWClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.sqlFirewallRulesClient = sqlFWClient
+	c.configureClient(&sqlFWClient.Client, auth)
+	clients.sqlFirewallRulesClient = sqlFWClient
 
 	sqlEPClient := sql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&sqlEPClient.Client)
-	sqlEPClient.Authorizer = auth
-	sqlEPClient.Sender = sender
-	sqlEPClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.sqlElasticPoolsClient = sqlEPClient
+	c.configureClient(&sqlEPClient.Client, auth)
+	clients.sqlElasticPoolsClient = sqlEPClient
 
 	sqlSrvClient := sql.NewServersClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&sqlSrvClient.Client)
-	sqlSrvClient.Authorizer = auth
-	sqlSrvClient.Sender = sender
-	sqlSrvClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.sqlServersClient = sqlSrvClient
+	c.configureClient(&sqlSrvClient.Client, auth)
+	clients.sqlServersClient = sqlSrvClient
+
+	return clients
+}
+
+// dnsClients contains the DNS clients, which are built the first time they're used.
+type dnsClients struct {
+	dnsClient   dns.RecordSetsClient
+	zonesClient dns.ZonesClient
+}
+
+func (c *ArmClient) dns() *dnsClients {
+	c.dnsOnce.Do(func() {
+		c.dnsGroup = c.newDnsClients()
+	})
+	return c.dnsGroup
 }
 
-func (c *ArmClient) registerDNSClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+func (c *ArmClient) newDnsClients() *dnsClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &dnsClients{}
+
 	dn := dns.NewRecordSetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&dn.Client, auth)
-	c.dnsClient = dn
+	clients.dnsClient = dn
 
 	zo := dns.NewZonesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&zo.Client, auth)
-	c.zonesClient = zo
+	clients.zonesClient = zo
+
+	return clients
 }
 
-func (c *ArmClient) registerEventGridClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+// eventGridClients contains the EventGrid clients, which are built the first time they're used.
+type eventGridClients struct {
+	eventGridTopicsClient eventgrid.TopicsClient
+}
+
+func (c *ArmClient) eventGrid() *eventGridClients {
+	c.eventGridOnce.Do(func() {
+		c.eventGridGroup = c.newEventGridClients()
+	})
+	return c.eventGridGroup
+}
+
+func (c *ArmClient) newEventGridClients() *eventGridClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &eventGridClients{}
+
 	egtc := eventgrid.NewTopicsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&egtc.Client)
-	egtc.Authorizer = auth
-	egtc.Sender = sender
-	egtc.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.eventGridTopicsClient = egtc
+	c.configureClient(&egtc.Client, auth)
+	clients.eventGridTopicsClient = egtc
+
+	return clients
 }
 
-func (c *ArmClient) registerEventHubClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+// eventHubClients contains the EventHub clients, which are built the first time they're used.
+type eventHubClients struct {
+	eventHubClient              eventhub.EventHubsClient
+	eventHubConsumerGroupClient eventhub.ConsumerGroupsClient
+	eventHubNamespacesClient    eventhub.NamespacesClient
+}
+
+func (c *ArmClient) eventHub() *eventHubClients {
+	c.eventHubOnce.Do(func() {
+		c.eventHubGroup = c.newEventHubClients()
+	})
+	return c.eventHubGroup
+}
+
+func (c *ArmClient) newEventHubClients() *eventHubClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &eventHubClients{}
+
 	ehc := eventhub.NewEventHubsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&ehc.Client)
-	ehc.Authorizer = auth
-	ehc.Sender = sender
-	ehc.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.eventHubClient = ehc
+	c.configureClient(&ehc.Client, auth)
+	clients.eventHubClient = ehc
 
 	chcgc := eventhub.NewConsumerGroupsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&chcgc.Client)
-	chcgc.Authorizer = auth
-	chcgc.Sender = sender
-	chcgc.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.eventHubConsumerGroupClient = chcgc
+	c.configureClient(&chcgc.Client, auth)
+	clients.eventHubConsumerGroupClient = chcgc
 
 	ehnc := eventhub.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&ehnc.Client)
-	ehnc.Authorizer = auth
-	ehnc.Sender = sender
-	ehnc.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.eventHubNamespacesClient = ehnc
+	c.configureClient(&ehnc.Client, auth)
+	clients.eventHubNamespacesClient = ehnc
+
+	return clients
+}
+
+// keyVaultClients contains the KeyVault clients, which are built the first time they're used.
+type keyVaultClients struct {
+	keyVaultClient           keyvault.VaultsClient
+	keyVaultManagementClient keyVault.BaseClient
+}
+
+func (c *ArmClient) keyVault() *keyVaultClients {
+	c.keyVaultOnce.Do(func() {
+		c.keyVaultGroup = c.newKeyVaultClients()
+	})
+	return c.keyVaultGroup
 }
 
-func (c *ArmClient) registerKeyVaultClients(endpoint, subscriptionId string, auth autorest.Authorizer, keyVaultAuth autorest.Authorizer, sender autorest.Sender) {
+func (c *ArmClient) newKeyVaultClients() *keyVaultClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	keyVaultAuth := c.keyVaultAuth
+	clients := &keyVaultClients{}
+
 	keyVaultClient := keyvault.NewVaultsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&keyVaultClient.Client)
-	keyVaultClient.Authorizer = auth
-	keyVaultClient.Sender = sender
-	keyVaultClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.keyVaultClient = keyVaultClient
+	c.configureClient(&keyVaultClient.Client, auth)
+	clients.keyVaultClient = keyVaultClient
 
 	keyVaultManagementClient := keyVault.New()
-	setUserAgent(&keyVaultManagementClient.Client)
-	keyVaultManagementClient.Authorizer = keyVaultAuth
-	keyVaultManagementClient.Sender = sender
-	keyVaultManagementClient.SkipResourceProviderRegistration = c.skipProviderRegistration
-	c.keyVaultManagementClient = keyVaultManagementClient
+	c.configureClient(&keyVaultManagementClient.Client, keyVaultAuth)
+	clients.keyVaultManagementClient = keyVaultManagementClient
+
+	return clients
 }
 
-func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+// monitorClients contains the Monitor clients, which are built the first time they're used.
+type monitorClients struct {
+	monitorAlertRulesClient insights.AlertRulesClient
+}
+
+func (c *ArmClient) monitor() *monitorClients {
+	c.monitorOnce.Do(func() {
+		c.monitorGroup = c.newMonitorClients()
+	})
+	return c.monitorGroup
+}
+
+func (c *ArmClient) newMonitorClients() *monitorClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &monitorClients{}
+
 	arc := insights.NewAlertRulesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&arc.Client)
-	arc.Authorizer = auth
-	arc.Sender = autorest.CreateSender(withRequestLogging())
-	c.monitorAlertRulesClient = arc
+	c.configureClient(&arc.Client, auth)
+	clients.monitorAlertRulesClient = arc
+
+	return clients
 }
 
-func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+// networkingClients contains the Networking clients, which are built the first time they're used.
+type networkingClients struct {
+	applicationGatewayClient     network.ApplicationGatewaysClient
+	expressRouteCircuitClient    network.ExpressRouteCircuitsClient
+	ifaceClient                  network.InterfacesClient
+	loadBalancerClient           network.LoadBalancersClient
+	localNetConnClient           network.LocalNetworkGatewaysClient
+	vnetGatewayClient            network.VirtualNetworkGatewaysClient
+	vnetGatewayConnectionsClient network.VirtualNetworkGatewayConnectionsClient
+	vnetClient                   network.VirtualNetworksClient
+	vnetPeeringsClient           network.VirtualNetworkPeeringsClient
+	publicIPClient               network.PublicIPAddressesClient
+	routesClient                 network.RoutesClient
+	routeTablesClient            network.RouteTablesClient
+	secGroupClient               network.SecurityGroupsClient
+	secRuleClient                network.SecurityRulesClient
+	subnetClient                 network.SubnetsClient
+	watcherClient                network.WatchersClient
+}
+
+func (c *ArmClient) networking() *networkingClients {
+	c.networkingOnce.Do(func() {
+		c.networkingGroup = c.newNetworkingClients()
+	})
+	return c.networkingGroup
+}
+
+func (c *ArmClient) newNetworkingClients() *networkingClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &networkingClients{}
+
 	applicationGatewaysClient := network.NewApplicationGatewaysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&applicationGatewaysClient.Client, auth)
-	c.applicationGatewayClient = applicationGatewaysClient
+	clients.applicationGatewayClient = applicationGatewaysClient
 
 	expressRouteCircuitsClient := network.NewExpressRouteCircuitsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRouteCircuitsClient.Client, auth)
-	c.expressRouteCircuitClient = expressRouteCircuitsClient
+	clients.expressRouteCircuitClient = expressRouteCircuitsClient
 
 	interfacesClient := network.NewInterfacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&interfacesClient.Client, auth)
-	c.ifaceClient = interfacesClient
+	clients.ifaceClient = interfacesClient
 
 	loadBalancersClient := network.NewLoadBalancersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&loadBalancersClient.Client, auth)
-	c.loadBalancerClient = loadBalancersClient
+	clients.loadBalancerClient = loadBalancersClient
 
 	localNetworkGatewaysClient := network.NewLocalNetworkGatewaysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&localNetworkGatewaysClient.Client, auth)
-	c.localNetConnClient = localNetworkGatewaysClient
+	clients.localNetConnClient = localNetworkGatewaysClient
 
 	gatewaysClient := network.NewVirtualNetworkGatewaysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&gatewaysClient.Client, auth)
-	c.vnetGatewayClient = gatewaysClient
+	clients.vnetGatewayClient = gatewaysClient
 
 	gatewayConnectionsClient := network.NewVirtualNetworkGatewayConnectionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&gatewayConnectionsClient.Client, auth)
-	c.vnetGatewayConnectionsClient = gatewayConnectionsClient
+	clients.vnetGatewayConnectionsClient = gatewayConnectionsClient
 
 	networksClient := network.NewVirtualNetworksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&networksClient.Client, auth)
-	c.vnetClient = networksClient
+	clients.vnetClient = networksClient
 
 	peeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&peeringsClient.Client, auth)
-	c.vnetPeeringsClient = peeringsClient
+	clients.vnetPeeringsClient = peeringsClient
 
 	publicIPAddressesClient := network.NewPublicIPAddressesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&publicIPAddressesClient.Client, auth)
-	c.publicIPClient = publicIPAddressesClient
+	clients.publicIPClient = publicIPAddressesClient
 
 	routesClient := network.NewRoutesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&routesClient.Client, auth)
-	c.routesClient = routesClient
+	clients.routesClient = routesClient
 
 	routeTablesClient := network.NewRouteTablesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&routeTablesClient.Client, auth)
-	c.routeTablesClient = routeTablesClient
+	clients.routeTablesClient = routeTablesClient
 
 	securityGroupsClient := network.NewSecurityGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&securityGroupsClient.Client, auth)
-	c.secGroupClient = securityGroupsClient
+	clients.secGroupClient = securityGroupsClient
 
 	securityRulesClient := network.NewSecurityRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&securityRulesClient.Client, auth)
-	c.secRuleClient = securityRulesClient
+	clients.secRuleClient = securityRulesClient
 
 	subnetsClient := network.NewSubnetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&subnetsClient.Client, auth)
-	c.subnetClient = subnetsClient
+	clients.subnetClient = subnetsClient
 
 	watchersClient := network.NewWatchersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&watchersClient.Client, auth)
-	c.watcherClient = watchersClient
+	clients.watcherClient = watchersClient
+
+	return clients
+}
+
+// operationalInsightsClients contains the Operational Insights clients, which are built the first time they're used.
+type operationalInsightsClients struct {
+	workspacesClient operationalinsights.WorkspacesClient
+}
+
+func (c *ArmClient) operationalInsights() *operationalInsightsClients {
+	c.operationalInsightsOnce.Do(func() {
+		c.operationalInsightsGroup = c.newOperationalInsightsClients()
+	})
+	return c.operationalInsightsGroup
 }
 
-func (c *ArmClient) registerOperationalInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
-	opwc := operationalinsights.NewWorkspacesClient(subscriptionId)
+func (c *ArmClient) newOperationalInsightsClients() *operationalInsightsClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &operationalInsightsClients{}
+
+	opwc := operationalinsights.NewWorkspacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&opwc.Client, auth)
-	c.workspacesClient = opwc
+	clients.workspacesClient = opwc
+
+	return clients
+}
+
+// redisClients contains the Redis clients, which are built the first time they're used.
+type redisClients struct {
+	redisClient               redis.Client
+	redisFirewallClient       redis.FirewallRuleClient
+	redisPatchSchedulesClient redis.PatchSchedulesClient
+}
+
+func (c *ArmClient) redis() *redisClients {
+	c.redisOnce.Do(func() {
+		c.redisGroup = c.newRedisClients()
+	})
+	return c.redisGroup
 }
 
-func (c *ArmClient) registerRedisClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+func (c *ArmClient) newRedisClients() *redisClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &redisClients{}
+
 	redisClient := redis.NewClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&redisClient.Client, auth)
-	c.redisClient = redisClient
+	clients.redisClient = redisClient
 
 	firewallRuleClient := redis.NewFirewallRuleClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&firewallRuleClient.Client, auth)
-	c.redisFirewallClient = firewallRuleClient
+	clients.redisFirewallClient = firewallRuleClient
 
 	patchSchedulesClient := redis.NewPatchSchedulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&patchSchedulesClient.Client, auth)
-	c.redisPatchSchedulesClient = patchSchedulesClient
+	clients.redisPatchSchedulesClient = patchSchedulesClient
+
+	return clients
+}
+
+// resourcesClients contains the Resources clients, which are built the first time they're used.
+type resourcesClients struct {
+	managementLocksClient locks.ManagementLocksClient
+	deploymentsClient     resources.DeploymentsClient
+	resourcesClient       resources.Client
+	resourceGroupsClient  resources.GroupsClient
+	providersClient       resources.ProvidersClient
+	subscriptionsClient   subscriptions.Client
+}
+
+func (c *ArmClient) resources() *resourcesClients {
+	c.resourcesOnce.Do(func() {
+		c.resourcesGroup = c.newResourcesClients()
+	})
+	return c.resourcesGroup
 }
 
-func (c *ArmClient) registerResourcesClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) newResourcesClients() *resourcesClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &resourcesClients{}
+
 	locksClient := locks.NewManagementLocksClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&locksClient.Client, auth)
-	c.managementLocksClient = locksClient
+	clients.managementLocksClient = locksClient
 
 	deploymentsClient := resources.NewDeploymentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&deploymentsClient.Client, auth)
-	c.deploymentsClient = deploymentsClient
+	clients.deploymentsClient = deploymentsClient
 
 	resourcesClient := resources.NewClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&resourcesClient.Client, auth)
-	c.resourcesClient = resourcesClient
+	clients.resourcesClient = resourcesClient
 
 	resourceGroupsClient := resources.NewGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&resourceGroupsClient.Client, auth)
-	c.resourceGroupsClient = resourceGroupsClient
+	clients.resourceGroupsClient = resourceGroupsClient
 
 	providersClient := resources.NewProvidersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&providersClient.Client, auth)
-	c.providersClient = providersClient
+	clients.providersClient = providersClient
 
 	subscriptionsClient := subscriptions.NewClientWithBaseURI(endpoint)
 	c.configureClient(&subscriptionsClient.Client, auth)
-	c.subscriptionsClient = subscriptionsClient
+	clients.subscriptionsClient = subscriptionsClient
+
+	return clients
 }
 
-func (c *ArmClient) registerSearchClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+// searchClients contains the Search clients, which are built the first time they're used.
+type searchClients struct {
+	searchServicesClient search.ServicesClient
+}
+
+func (c *ArmClient) search() *searchClients {
+	c.searchOnce.Do(func() {
+		c.searchGroup = c.newSearchClients()
+	})
+	return c.searchGroup
+}
+
+func (c *ArmClient) newSearchClients() *searchClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &searchClients{}
+
 	searchClient := search.NewServicesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&searchClient.Client, auth)
-	c.searchServicesClient = searchClient
+	clients.searchServicesClient = searchClient
+
+	return clients
 }
 
-func (c *ArmClient) registerServiceBusClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+// serviceBusClients contains the ServiceBus clients, which are built the first time they're used.
+type serviceBusClients struct {
+	serviceBusQueuesClient        servicebus.QueuesClient
+	serviceBusNamespacesClient    servicebus.NamespacesClient
+	serviceBusTopicsClient        servicebus.TopicsClient
+	serviceBusSubscriptionsClient servicebus.SubscriptionsClient
+}
+
+func (c *ArmClient) serviceBus() *serviceBusClients {
+	c.serviceBusOnce.Do(func() {
+		c.serviceBusGroup = c.newServiceBusClients()
+	})
+	return c.serviceBusGroup
+}
+
+func (c *ArmClient) newServiceBusClients() *serviceBusClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &serviceBusClients{}
+
 	queuesClient := servicebus.NewQueuesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&queuesClient.Client, auth)
-	c.serviceBusQueuesClient = queuesClient
+	clients.serviceBusQueuesClient = queuesClient
 
 	namespacesClient := servicebus.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&namespacesClient.Client, auth)
-	c.serviceBusNamespacesClient = namespacesClient
+	clients.serviceBusNamespacesClient = namespacesClient
 
 	topicsClient := servicebus.NewTopicsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&topicsClient.Client, auth)
-	c.serviceBusTopicsClient = topicsClient
+	clients.serviceBusTopicsClient = topicsClient
 
 	subscriptionsClient := servicebus.NewSubscriptionsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&subscriptionsClient.Client, auth)
-	c.serviceBusSubscriptionsClient = subscriptionsClient
+	clients.serviceBusSubscriptionsClient = subscriptionsClient
+
+	return clients
+}
+
+// storageClients contains the Storage clients, which are built the first time they're used.
+type storageClients struct {
+	storageServiceClient storage.AccountsClient
+	storageUsageClient   storage.UsageClient
 }
 
-func (c *ArmClient) registerStorageClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) storage() *storageClients {
+	c.storageOnce.Do(func() {
+		c.storageGroup = c.newStorageClients()
+	})
+	return c.storageGroup
+}
+
+func (c *ArmClient) newStorageClients() *storageClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &storageClients{}
+
 	accountsClient := storage.NewAccountsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&accountsClient.Client, auth)
-	c.storageServiceClient = accountsClient
+	clients.storageServiceClient = accountsClient
 
 	usageClient := storage.NewUsageClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&usageClient.Client, auth)
-	c.storageUsageClient = usageClient
+	clients.storageUsageClient = usageClient
+
+	return clients
 }
 
-func (c *ArmClient) registerTrafficManagerClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+// trafficManagerClients contains the Traffic Manager clients, which are built the first time they're used.
+type trafficManagerClients struct {
+	trafficManagerEndpointsClient trafficmanager.EndpointsClient
+	trafficManagerProfilesClient  trafficmanager.ProfilesClient
+}
+
+func (c *ArmClient) trafficManager() *trafficManagerClients {
+	c.trafficManagerOnce.Do(func() {
+		c.trafficManagerGroup = c.newTrafficManagerClients()
+	})
+	return c.trafficManagerGroup
+}
+
+func (c *ArmClient) newTrafficManagerClients() *trafficManagerClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &trafficManagerClients{}
+
 	endpointsClient := trafficmanager.NewEndpointsClientWithBaseURI(endpoint, c.subscriptionId)
 	c.configureClient(&endpointsClient.Client, auth)
-	c.trafficManagerEndpointsClient = endpointsClient
+	clients.trafficManagerEndpointsClient = endpointsClient
 
 	profilesClient := trafficmanager.NewProfilesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&profilesClient.Client, auth)
-	c.trafficManagerProfilesClient = profilesClient
+	clients.trafficManagerProfilesClient = profilesClient
+
+	return clients
 }
 
-func (c *ArmClient) registerWebClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+// webClients contains the Web clients, which are built the first time they're used.
+type webClients struct {
+	appServicePlansClient web.AppServicePlansClient
+	appServicesClient     web.AppsClient
+	webClient             web.BaseClient
+}
+
+func (c *ArmClient) web() *webClients {
+	c.webOnce.Do(func() {
+		c.webGroup = c.newWebClients()
+	})
+	return c.webGroup
+}
+
+func (c *ArmClient) newWebClients() *webClients {
+	endpoint := c.resourceManagerEndpoint
+	subscriptionId := c.subscriptionId
+	auth := c.resourceManagerAuth
+	clients := &webClients{}
+
 	appServicePlansClient := web.NewAppServicePlansClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&appServicePlansClient.Client, auth)
-	c.appServicePlansClient = appServicePlansClient
+	clients.appServicePlansClient = appServicePlansClient
 
 	appsClient := web.NewAppsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&appsClient.Client, auth)
-	c.appServicesClient = appsClient
+	clients.appServicesClient = appsClient
+
+	webClient := web.NewWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&webClient.Client, auth)
+	clients.webClient = webClient
+
+	return clients
+}
+
+// subscriptionIdFromResourceId returns the Subscription ID within the specified Resource ID - falling back
+// to the Subscription the Provider is configured for when one isn't present.
+func (c *ArmClient) subscriptionIdFromResourceId(resourceId string) string {
+	id, err := parseAzureResourceID(resourceId)
+	if err != nil || id.SubscriptionID == "" {
+		return c.subscriptionId
+	}
+
+	return id.SubscriptionID
+}
+
+func (c *ArmClient) isProviderSubscription(subscriptionId string) bool {
+	return subscriptionId == "" || strings.EqualFold(subscriptionId, c.subscriptionId)
+}
+
+// vnetGatewayConnectionsClientForSubscription returns a Virtual Network Gateway Connections client which
+// manages Connections within the specified Subscription.
+func (c *ArmClient) vnetGatewayConnectionsClientForSubscription(subscriptionId string) network.VirtualNetworkGatewayConnectionsClient {
+	if c.isProviderSubscription(subscriptionId) {
+		return c.networking().vnetGatewayConnectionsClient
+	}
+
+	client := network.NewVirtualNetworkGatewayConnectionsClientWithBaseURI(c.resourceManagerEndpoint, subscriptionId)
+	c.configureClient(&client.Client, c.resourceManagerAuth)
+	return client
+}
+
+// vnetPeeringsClientForSubscription returns a Virtual Network Peerings client which manages Peerings
+// within the specified Subscription.
+func (c *ArmClient) vnetPeeringsClientForSubscription(subscriptionId string) network.VirtualNetworkPeeringsClient {
+	if c.isProviderSubscription(subscriptionId) {
+		return c.networking().vnetPeeringsClient
+	}
+
+	client := network.NewVirtualNetworkPeeringsClientWithBaseURI(c.resourceManagerEndpoint, subscriptionId)
+	c.configureClient(&client.Client, c.resourceManagerAuth)
+	return client
 }
 
 var (
@@ -836,7 +1472,7 @@ func (armClient *ArmClient) getKeyForStorageAccount(resourceGroupName, storageAc
 	defer storageKeyCacheMu.Unlock()
 	key, ok = storageKeyCache[cacheIndex]
 	if !ok {
-		accountKeys, err := armClient.storageServiceClient.ListKeys(resourceGroupName, storageAccountName)
+		accountKeys, err := armClient.storage().storageServiceClient.ListKeys(resourceGroupName, storageAccountName)
 		if utils.ResponseWasNotFound(accountKeys.Response) {
 			return "", false, nil
 		}
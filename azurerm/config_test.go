@@ -0,0 +1,267 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
+)
+
+func TestGetAuthorizationToken_Msi(t *testing.T) {
+	expectedResource := "https://management.azure.com/"
+	var requestedResource string
+
+	// a local stand-in for the VM's MSI metadata endpoint
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requestedResource = r.Form.Get("resource")
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"msi-token","expires_in":"3600","expires_on":"4102444800","not_before":"1514764800","resource":%q,"token_type":"Bearer"}`, requestedResource)
+	}))
+	defer server.Close()
+
+	config := &authentication.Config{
+		UseMsi:      true,
+		MsiEndpoint: server.URL,
+	}
+
+	auth, err := getAuthorizationToken(config, azure.PublicCloud, nil, expectedResource)
+	if err != nil {
+		t.Fatalf("Error building the MSI Authorizer: %+v", err)
+	}
+
+	req, err := autorest.Prepare(&http.Request{}, auth.WithAuthorization())
+	if err != nil {
+		t.Fatalf("Error authorizing the request: %+v", err)
+	}
+
+	if requestedResource != expectedResource {
+		t.Fatalf("Expected a token to be requested for %q but got %q", expectedResource, requestedResource)
+	}
+
+	if actual := req.Header.Get("Authorization"); actual != "Bearer msi-token" {
+		t.Fatalf("Expected the Authorization header to be %q but got %q", "Bearer msi-token", actual)
+	}
+}
+
+func TestGetAuthorizationToken_AzureCLIRefresh(t *testing.T) {
+	var grantType, refreshToken, requestedResource string
+
+	// a local stand-in for the Azure Active Directory token endpoint
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		grantType = r.Form.Get("grant_type")
+		refreshToken = r.Form.Get("refresh_token")
+		requestedResource = r.Form.Get("resource")
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"refreshed-token","refresh_token":"new-refresh-token","expires_in":"3600","expires_on":"4102444800","resource":%q,"token_type":"Bearer"}`, requestedResource)
+	}))
+	defer server.Close()
+
+	oauthConfig, err := adal.NewOAuthConfig(server.URL, "00000000-0000-0000-0000-000000000000")
+	if err != nil {
+		t.Fatalf("Error building the OAuth Config: %+v", err)
+	}
+
+	testCases := []struct {
+		Name     string
+		Resource string
+		Token    adal.Token
+	}{
+		{
+			Name:     "Expired Resource Manager Token",
+			Resource: "https://management.azure.com/",
+			Token: adal.Token{
+				AccessToken:  "expired-token",
+				RefreshToken: "cli-refresh-token",
+				ExpiresOn:    "1514764800",
+				Resource:     "https://management.core.windows.net/",
+				Type:         "Bearer",
+			},
+		},
+		{
+			Name:     "Valid Resource Manager Token used for Graph",
+			Resource: "https://graph.windows.net/",
+			Token: adal.Token{
+				AccessToken:  "management-token",
+				RefreshToken: "cli-refresh-token",
+				ExpiresOn:    "4102444800",
+				Resource:     "https://management.core.windows.net/",
+				Type:         "Bearer",
+			},
+		},
+	}
+
+	for _, v := range testCases {
+		token := v.Token
+		config := &authentication.Config{
+			ClientID:    "04b07795-8ddb-461a-bbee-02f9e1bf7b46",
+			AccessToken: &token,
+		}
+
+		auth, err := getAuthorizationToken(config, azure.PublicCloud, oauthConfig, v.Resource)
+		if err != nil {
+			t.Fatalf("Error building the Authorizer for %q: %+v", v.Name, err)
+		}
+
+		req, err := autorest.Prepare(&http.Request{}, auth.WithAuthorization())
+		if err != nil {
+			t.Fatalf("Error authorizing the request for %q: %+v", v.Name, err)
+		}
+
+		if grantType != "refresh_token" || refreshToken != "cli-refresh-token" {
+			t.Fatalf("Expected the token for %q to be refreshed using the Refresh Token but got %q / %q", v.Name, grantType, refreshToken)
+		}
+
+		if requestedResource != v.Resource {
+			t.Fatalf("Expected a token for %q to be requested for %q but got %q", v.Name, v.Resource, requestedResource)
+		}
+
+		if actual := req.Header.Get("Authorization"); actual != "Bearer refreshed-token" {
+			t.Fatalf("Expected the Authorization header for %q to be %q but got %q", v.Name, "Bearer refreshed-token", actual)
+		}
+	}
+}
+
+func TestDecodeClientCertificate(t *testing.T) {
+	certificate, privateKey, err := decodeClientCertificate("testdata/application_gateway_test.pfx", "terraform")
+	if err != nil {
+		t.Fatalf("Error decoding the Client Certificate: %+v", err)
+	}
+
+	if certificate == nil {
+		t.Fatalf("Expected a Certificate to be returned but got nil")
+	}
+
+	if privateKey == nil {
+		t.Fatalf("Expected a Private Key to be returned but got nil")
+	}
+
+	if _, _, err := decodeClientCertificate("testdata/application_gateway_test.pfx", "incorrect"); err == nil {
+		t.Fatalf("Expected an error when decoding the Client Certificate with the wrong password but didn't get one")
+	}
+
+	if _, _, err := decodeClientCertificate("testdata/does-not-exist.pfx", ""); err == nil {
+		t.Fatalf("Expected an error when decoding a Client Certificate which doesn't exist but didn't get one")
+	}
+}
+
+func TestLazyAuthorizer(t *testing.T) {
+	builds := 0
+	auth := &lazyAuthorizer{
+		build: func() (autorest.Authorizer, error) {
+			builds++
+			return autorest.NewBearerAuthorizer(testStaticToken("lazy-token")), nil
+		},
+	}
+
+	client := &ArmClient{
+		subscriptionId:          "00000000-0000-0000-0000-000000000000",
+		resourceManagerEndpoint: "https://management.azure.com/",
+		resourceManagerAuth:     auth,
+		graphAuth:               auth,
+	}
+	client.resources()
+	client.authorization()
+
+	if builds != 0 {
+		t.Fatalf("Expected no tokens to be requested until a request is made but got %d", builds)
+	}
+
+	for i := 0; i < 2; i++ {
+		req, err := autorest.Prepare(&http.Request{}, auth.WithAuthorization())
+		if err != nil {
+			t.Fatalf("Error authorizing the request: %+v", err)
+		}
+
+		if actual := req.Header.Get("Authorization"); actual != "Bearer lazy-token" {
+			t.Fatalf("Expected the Authorization header to be %q but got %q", "Bearer lazy-token", actual)
+		}
+	}
+
+	if builds != 1 {
+		t.Fatalf("Expected the Authorizer to be built once but got %d", builds)
+	}
+}
+
+func TestLazyAuthorizer_BuildFails(t *testing.T) {
+	builds := 0
+	auth := &lazyAuthorizer{
+		build: func() (autorest.Authorizer, error) {
+			builds++
+			if builds == 1 {
+				return nil, fmt.Errorf("the MSI endpoint is unavailable")
+			}
+
+			return autorest.NewBearerAuthorizer(testStaticToken("lazy-token")), nil
+		},
+	}
+
+	if _, err := autorest.Prepare(&http.Request{}, auth.WithAuthorization()); err == nil {
+		t.Fatalf("Expected an error when the Authorizer couldn't be built")
+	}
+
+	// the failure isn't kept, so the next request builds the Authorizer again
+	for i := 0; i < 2; i++ {
+		req, err := autorest.Prepare(&http.Request{}, auth.WithAuthorization())
+		if err != nil {
+			t.Fatalf("Error authorizing the request: %+v", err)
+		}
+
+		if actual := req.Header.Get("Authorization"); actual != "Bearer lazy-token" {
+			t.Fatalf("Expected the Authorization header to be %q but got %q", "Bearer lazy-token", actual)
+		}
+	}
+
+	if builds != 2 {
+		t.Fatalf("Expected the Authorizer to be built twice but got %d", builds)
+	}
+}
+
+func TestArmClientClientsAreBuiltOnce(t *testing.T) {
+	client := &ArmClient{
+		subscriptionId:          "00000000-0000-0000-0000-000000000000",
+		resourceManagerEndpoint: "https://management.azure.com/",
+		resourceManagerAuth:     autorest.NullAuthorizer{},
+	}
+
+	if client.networkingGroup != nil {
+		t.Fatalf("Expected the Networking clients not to be built until they're used")
+	}
+
+	groups := make(chan *networkingClients, 10)
+	for i := 0; i < cap(groups); i++ {
+		go func() {
+			groups <- client.networking()
+		}()
+	}
+
+	first := <-groups
+	for i := 1; i < cap(groups); i++ {
+		if group := <-groups; group != first {
+			t.Fatalf("Expected the Networking clients to only be built once")
+		}
+	}
+
+	if first.vnetClient.SubscriptionID != client.subscriptionId || first.vnetClient.BaseURI != client.resourceManagerEndpoint {
+		t.Fatalf("Expected the Networking clients to be configured for %q / %q but got %q / %q", client.subscriptionId, client.resourceManagerEndpoint, first.vnetClient.SubscriptionID, first.vnetClient.BaseURI)
+	}
+}
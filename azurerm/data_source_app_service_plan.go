@@ -82,7 +82,7 @@ func dataSourceAppServicePlan() *schema.Resource {
 }
 
 func dataSourceAppServicePlanRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).appServicePlansClient
+	client := meta.(*ArmClient).web().appServicePlansClient
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -120,7 +120,7 @@ func dataSourceAppServicePlanRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("sku", flattenAppServicePlanSku(sku))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTagsForDataSource(d, resp.Tags)
 
 	return nil
 }
@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccDataSourceAzureRMAppServicePlan_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_app_service_plan.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -35,7 +34,7 @@ func TestAccDataSourceAzureRMAppServicePlan_basic(t *testing.T) {
 
 func TestAccDataSourceAzureRMAppServicePlan_complete(t *testing.T) {
 	dataSourceName := "data.azurerm_app_service_plan.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccDataSourceAzureRMDNSZone_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_dns_zone.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -29,7 +28,7 @@ func TestAccDataSourceAzureRMDNSZone_basic(t *testing.T) {
 
 func TestAccDataSourceAzureRMDNSZone_tags(t *testing.T) {
 	dataSourceName := "data.azurerm_dns_zone.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -68,7 +68,7 @@ func dataSourceEventHubNamespace() *schema.Resource {
 }
 
 func dataSourceEventHubNamespaceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).eventHubNamespacesClient
+	client := meta.(*ArmClient).eventHub().eventHubNamespacesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -107,7 +107,7 @@ func dataSourceEventHubNamespaceRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("maximum_throughput_units", int(*props.MaximumThroughputUnits))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTagsForDataSource(d, resp.Tags)
 
 	return nil
 }
@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccDataSourceAzureRMEventHubNamespace_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_eventhub_namespace.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -29,7 +28,7 @@ func TestAccDataSourceAzureRMEventHubNamespace_basic(t *testing.T) {
 
 func TestAccDataSourceAzureRMEventHubNamespace_complete(t *testing.T) {
 	dataSourceName := "data.azurerm_eventhub_namespace.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccDataSourceAzureRMImage_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_image.test"
 
-	config := testAccDataSourceAzureRMImageBasic(acctest.RandInt(), acctest.RandString(4), testLocation())
+	config := testAccDataSourceAzureRMImageBasic(testAccRandInt(t), testAccRandString(t, 4), testLocation())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -42,8 +41,8 @@ func TestAccDataSourceAzureRMImage_localFilter(t *testing.T) {
 	ascDataSourceName := "data.azurerm_image.test1"
 	descDataSourceName := "data.azurerm_image.test2"
 
-	ri := acctest.RandInt()
-	config := testAccDataSourceAzureRMImageLocalFilter(ri, acctest.RandString(4), testLocation())
+	ri := testAccRandInt(t)
+	config := testAccDataSourceAzureRMImageLocalFilter(ri, testAccRandString(t, 4), testLocation())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
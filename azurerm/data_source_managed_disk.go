@@ -50,7 +50,7 @@ func dataSourceArmManagedDisk() *schema.Resource {
 }
 
 func dataSourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).diskClient
+	client := meta.(*ArmClient).compute().diskClient
 	ctx := meta.(*ArmClient).StopContext
 
 	resGroup := d.Get("resource_group_name").(string)
@@ -84,7 +84,7 @@ func dataSourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) erro
 		flattenAzureRmManagedDiskCreationData(d, resp.CreationData)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTagsForDataSource(d, resp.Tags)
 
 	return nil
 }
@@ -84,7 +84,7 @@ func dataSourceArmNetworkSecurityGroup() *schema.Resource {
 }
 
 func dataSourceArmNetworkSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).secGroupClient
+	client := meta.(*ArmClient).networking().secGroupClient
 	ctx := meta.(*ArmClient).StopContext
 
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -108,7 +108,7 @@ func dataSourceArmNetworkSecurityGroupRead(d *schema.ResourceData, meta interfac
 		d.Set("security_rule", flattenNetworkSecurityRules(props.SecurityRules))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTagsForDataSource(d, resp.Tags)
 
 	return nil
 }
@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccDataSourceAzureRMNetworkSecurityGroup_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_network_security_group.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccDataSourceAzureRMNetworkSecurityGroupBasic(ri, location)
 
@@ -33,7 +32,7 @@ func TestAccDataSourceAzureRMNetworkSecurityGroup_basic(t *testing.T) {
 
 func TestAccDataSourceAzureRMNetworkSecurityGroup_rules(t *testing.T) {
 	dataSourceName := "data.azurerm_network_security_group.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccDataSourceAzureRMNetworkSecurityGroupWithRules(ri, location)
 
@@ -65,7 +64,7 @@ func TestAccDataSourceAzureRMNetworkSecurityGroup_rules(t *testing.T) {
 
 func TestAccDataSourceAzureRMNetworkSecurityGroup_tags(t *testing.T) {
 	dataSourceName := "data.azurerm_network_security_group.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccDataSourceAzureRMNetworkSecurityGroupTags(ri, location)
 
@@ -37,7 +37,7 @@ func dataSourceArmPlatformImage() *schema.Resource {
 }
 
 func dataSourceArmPlatformImageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vmImageClient
+	client := meta.(*ArmClient).compute().vmImageClient
 	ctx := meta.(*ArmClient).StopContext
 
 	location := azureRMNormalizeLocation(d.Get("location").(string))
@@ -0,0 +1,211 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateGenericResourceType,
+			},
+
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tag_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tag_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"location": locationForDataSourceSchema(),
+
+						"tags": tagsForDataSourceSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourcesFilter contains the criteria the resources returned by the data source need to match
+type resourcesFilter struct {
+	resourceType string
+	namePrefix   string
+	tagName      string
+	tagValue     string
+}
+
+func dataSourceArmResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resources().resourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	filter := resourcesFilter{
+		resourceType: d.Get("type").(string),
+		namePrefix:   d.Get("name_prefix").(string),
+		tagName:      d.Get("tag_name").(string),
+		tagValue:     d.Get("tag_value").(string),
+	}
+	if filter.tagValue != "" && filter.tagName == "" {
+		return fmt.Errorf("`tag_name` must be specified when `tag_value` is specified")
+	}
+
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	var iterator resources.ListResultIterator
+	var err error
+	if resourceGroup != "" {
+		iterator, err = client.ListByResourceGroupComplete(ctx, resourceGroup, filter.odata(), "", nil)
+	} else {
+		iterator, err = client.ListComplete(ctx, filter.odata(), "", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("Error listing Resources (Resource Group %q / Filter %q): %+v", resourceGroup, filter.odata(), err)
+	}
+
+	results := make([]interface{}, 0)
+	for iterator.NotDone() {
+		resource := iterator.Value()
+		if filter.matches(resource) {
+			results = append(results, flattenArmResource(resource))
+		}
+
+		if err := iterator.Next(); err != nil {
+			return fmt.Errorf("Error listing Resources (Resource Group %q / Filter %q): %+v", resourceGroup, filter.odata(), err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("resources", results); err != nil {
+		return fmt.Errorf("Error setting `resources`: %+v", err)
+	}
+
+	return nil
+}
+
+// odata returns the OData filter which is sent to Azure. Azure doesn't allow filtering by a tag in combination with
+// any other criteria - as such the resources returned are also filtered by matches.
+func (f resourcesFilter) odata() string {
+	escape := func(input string) string {
+		return strings.Replace(input, "'", "''", -1)
+	}
+
+	if f.tagName != "" {
+		filter := fmt.Sprintf("tagName eq '%s'", escape(f.tagName))
+		if f.tagValue != "" {
+			filter += fmt.Sprintf(" and tagValue eq '%s'", escape(f.tagValue))
+		}
+		return filter
+	}
+
+	clauses := make([]string, 0)
+	if f.resourceType != "" {
+		clauses = append(clauses, fmt.Sprintf("resourceType eq '%s'", escape(f.resourceType)))
+	}
+	if f.namePrefix != "" {
+		clauses = append(clauses, fmt.Sprintf("substringof('%s', name)", escape(f.namePrefix)))
+	}
+
+	return strings.Join(clauses, " and ")
+}
+
+// matches returns whether the resource matches all of the criteria. Resource types, names and tag names are
+// case-insensitive in Azure, so they're compared as such.
+func (f resourcesFilter) matches(resource resources.GenericResource) bool {
+	if f.resourceType != "" && (resource.Type == nil || !strings.EqualFold(*resource.Type, f.resourceType)) {
+		return false
+	}
+
+	if f.namePrefix != "" && (resource.Name == nil || !strings.HasPrefix(strings.ToLower(*resource.Name), strings.ToLower(f.namePrefix))) {
+		return false
+	}
+
+	if f.tagName == "" {
+		return true
+	}
+
+	if resource.Tags == nil {
+		return false
+	}
+
+	for name, value := range *resource.Tags {
+		if !strings.EqualFold(name, f.tagName) {
+			continue
+		}
+
+		return f.tagValue == "" || (value != nil && *value == f.tagValue)
+	}
+
+	return false
+}
+
+func flattenArmResource(resource resources.GenericResource) map[string]interface{} {
+	output := make(map[string]interface{})
+	if resource.ID != nil {
+		output["id"] = *resource.ID
+	}
+	if resource.Name != nil {
+		output["name"] = *resource.Name
+	}
+	if resource.Type != nil {
+		output["type"] = *resource.Type
+	}
+	if resource.Location != nil {
+		output["location"] = azureRMNormalizeLocation(*resource.Location)
+	}
+
+	tags := make(map[string]interface{})
+	if resource.Tags != nil {
+		for k, v := range *resource.Tags {
+			if v != nil {
+				tags[k] = *v
+			}
+		}
+	}
+	output["tags"] = tags
+
+	return output
+}
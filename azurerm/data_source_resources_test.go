@@ -0,0 +1,169 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestResourcesFilterOData(t *testing.T) {
+	cases := []struct {
+		Filter   resourcesFilter
+		Expected string
+	}{
+		{
+			Filter:   resourcesFilter{},
+			Expected: "",
+		},
+		{
+			Filter:   resourcesFilter{resourceType: "Microsoft.Network/virtualNetworks"},
+			Expected: "resourceType eq 'Microsoft.Network/virtualNetworks'",
+		},
+		{
+			Filter:   resourcesFilter{resourceType: "Microsoft.Network/virtualNetworks", namePrefix: "hub"},
+			Expected: "resourceType eq 'Microsoft.Network/virtualNetworks' and substringof('hub', name)",
+		},
+		{
+			Filter:   resourcesFilter{namePrefix: "o'brien"},
+			Expected: "substringof('o''brien', name)",
+		},
+		{
+			Filter:   resourcesFilter{resourceType: "Microsoft.Network/virtualNetworks", tagName: "hub", tagValue: "true"},
+			Expected: "tagName eq 'hub' and tagValue eq 'true'",
+		},
+		{
+			Filter:   resourcesFilter{tagName: "hub"},
+			Expected: "tagName eq 'hub'",
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := tc.Filter.odata(); actual != tc.Expected {
+			t.Fatalf("Expected the filter for %+v to be %q but got %q", tc.Filter, tc.Expected, actual)
+		}
+	}
+}
+
+func TestResourcesFilterMatches(t *testing.T) {
+	network := resources.GenericResource{
+		Name: utils.String("hub-network"),
+		Type: utils.String("Microsoft.Network/virtualNetworks"),
+		Tags: &map[string]*string{
+			"Hub": utils.String("true"),
+		},
+	}
+
+	cases := []struct {
+		Filter   resourcesFilter
+		Expected bool
+	}{
+		{
+			Filter:   resourcesFilter{},
+			Expected: true,
+		},
+		{
+			Filter:   resourcesFilter{resourceType: "microsoft.network/virtualnetworks", namePrefix: "HUB-"},
+			Expected: true,
+		},
+		{
+			Filter:   resourcesFilter{resourceType: "Microsoft.Network/publicIPAddresses"},
+			Expected: false,
+		},
+		{
+			Filter:   resourcesFilter{namePrefix: "network"},
+			Expected: false,
+		},
+		{
+			Filter:   resourcesFilter{resourceType: "Microsoft.Network/virtualNetworks", tagName: "hub", tagValue: "true"},
+			Expected: true,
+		},
+		{
+			Filter:   resourcesFilter{tagName: "hub"},
+			Expected: true,
+		},
+		{
+			Filter:   resourcesFilter{tagName: "hub", tagValue: "false"},
+			Expected: false,
+		},
+		{
+			Filter:   resourcesFilter{tagName: "spoke"},
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := tc.Filter.matches(network); actual != tc.Expected {
+			t.Fatalf("Expected the filter %+v to match: %t", tc.Filter, tc.Expected)
+		}
+	}
+}
+
+func TestAccDataSourceAzureRMResources_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := testAccRandInt(t)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// the resources need to exist before they can be listed
+				Config: testAccDataSourceAzureRMResources_template(ri, location),
+			},
+			{
+				Config: testAccDataSourceAzureRMResources_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.id", "azurerm_virtual_network.hub", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.type", "Microsoft.Network/virtualNetworks"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.location", azureRMNormalizeLocation(location)),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.hub", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMResources_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  type                = "Microsoft.Network/virtualNetworks"
+  tag_name            = "hub"
+  tag_value           = "true"
+}
+`, testAccDataSourceAzureRMResources_template(rInt, location))
+}
+
+func testAccDataSourceAzureRMResources_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "hub" {
+  name                = "acctestvirtnet-hub-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags {
+    hub = "true"
+  }
+}
+
+resource "azurerm_virtual_network" "spoke" {
+  name                = "acctestvirtnet-spoke-%d"
+  address_space       = ["10.1.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt, rInt)
+}
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
@@ -13,7 +12,7 @@ func TestAccDataSourceAzureRMRoleDefinition_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_role_definition.test"
 
 	id := uuid.New().String()
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccDataSourceAzureRMSnapshot_importBasic(t *testing.T) {
 	dataSourceName := "data.azurerm_snapshot.snapshot"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -29,8 +28,8 @@ func TestAccDataSourceAzureRMSnapshot_importBasic(t *testing.T) {
 
 func TestAccDataSourceAzureRMSnapshot_importEncryption(t *testing.T) {
 	dataSourceName := "data.azurerm_snapshot.snapshot"
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
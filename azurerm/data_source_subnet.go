@@ -52,7 +52,7 @@ func dataSourceArmSubnet() *schema.Resource {
 }
 
 func dataSourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).subnetClient
+	client := meta.(*ArmClient).networking().subnetClient
 	ctx := meta.(*ArmClient).StopContext
 
 	name := d.Get("name").(string)
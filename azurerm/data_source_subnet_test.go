@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccDataSourceAzureRMSubnet_basic(t *testing.T) {
 	resourceName := "data.azurerm_subnet.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -33,7 +32,7 @@ func TestAccDataSourceAzureRMSubnet_basic(t *testing.T) {
 
 func TestAccDataSourceAzureRMSubnet_networkSecurityGroup(t *testing.T) {
 	dataSourceName := "data.azurerm_subnet.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -56,7 +55,7 @@ func TestAccDataSourceAzureRMSubnet_networkSecurityGroup(t *testing.T) {
 
 func TestAccDataSourceAzureRMSubnet_routeTable(t *testing.T) {
 	dataSourceName := "data.azurerm_subnet.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
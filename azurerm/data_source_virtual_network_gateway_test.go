@@ -4,12 +4,11 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMDataSourceVirtualNetworkGateway_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMDataSourceVirtualNetworkGateway_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccDataSourceArmVirtualNetwork_basic(t *testing.T) {
 	dataSourceName := "data.azurerm_virtual_network.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	name := fmt.Sprintf("acctestvnet-%d", ri)
 	config := testAccDataSourceArmVirtualNetwork_basic(ri, testLocation())
@@ -34,7 +33,7 @@ func TestAccDataSourceArmVirtualNetwork_basic(t *testing.T) {
 
 func TestAccDataSourceArmVirtualNetwork_peering(t *testing.T) {
 	dataSourceName := "data.azurerm_virtual_network.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	virtualNetworkName := fmt.Sprintf("acctestvnet-1-%d", ri)
 	location := testLocation()
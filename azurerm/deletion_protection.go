@@ -0,0 +1,33 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// deletionProtectionSchema returns the schema for `deletion_protection`, which prevents resources whose data can't
+// be recovered once they're deleted (e.g. databases) from being deleted - since `prevent_destroy` is easily lost
+// when the configuration is refactored.
+func deletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+// setDeletionProtection retains the configured value of `deletion_protection`, which isn't returned by Azure - so
+// that it's set in the State when the resource is imported
+func setDeletionProtection(d *schema.ResourceData) {
+	d.Set("deletion_protection", d.Get("deletion_protection").(bool))
+}
+
+// checkDeletionProtection returns an error if `deletion_protection` is enabled for the resource being deleted
+func checkDeletionProtection(d *schema.ResourceData, description string, name string) error {
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Unable to delete %s %q since `deletion_protection` is enabled - to delete it, set `deletion_protection` to `false` and apply the configuration first", description, name)
+	}
+
+	return nil
+}
@@ -0,0 +1,1000 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/emulator"
+)
+
+// these tests run the Create, Read, Update and Delete functions of the core resources against an in-memory
+// emulation of Azure Resource Manager - as such they run as unit tests, without credentials
+
+func TestEmulatedAzureRMResource_basic(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resourceName := "azurerm_resource.test"
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMResource_basic(server, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.properties.provisioningState", "Succeeded"),
+				),
+			},
+			{
+				Config: testEmulatedAzureRMResource_basic(server, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, resourceName),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources[resourceName]
+						network, _ := server.Resource(rs.Primary.ID)
+						if !strings.Contains(fmt.Sprintf("%+v", network["properties"]), "10.1.0.0/16") {
+							return fmt.Errorf("Bad: expected the Address Space of %q to be updated but got %+v", rs.Primary.ID, network["properties"])
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMResource_requiresImport(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMResource_basic(server, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, "azurerm_resource.test"),
+				),
+			},
+			{
+				Config:      testEmulatedAzureRMResource_requiresImport(server),
+				ExpectError: regexp.MustCompile("already exists - to be managed via Terraform this resource needs to be imported into the State"),
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMResourceGroup_basic(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resourceName := "azurerm_resource_group.test"
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMResourceGroup(server, "production"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "example-resources"),
+					resource.TestCheckResourceAttr(resourceName, "location", "westeurope"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "production"),
+				),
+			},
+			{
+				Config: testEmulatedAzureRMResourceGroup(server, "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "staging"),
+				),
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMResourceGroup_defaultTagAdded(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resourceName := "azurerm_resource_group.test"
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMResourceGroup_defaultTags(server, `cost_center = "1234"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.cost_center", "1234"),
+				),
+			},
+			{
+				// the default tag added after the Resource Group was created is applied to it
+				Config: testEmulatedAzureRMResourceGroup_defaultTags(server, `cost_center = "1234"
+      owner       = "platform"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceTag(server, resourceName, "owner", "platform"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.owner", "platform"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "production"),
+				),
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMResourceGroup_disappears(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resourceName := "azurerm_resource_group.test"
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMResourceGroup(server, "production"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, resourceName),
+					testCheckEmulatedResourceDisappears(server, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMResourceGroup_requiresImport(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMResourceGroup(server, "production"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, "azurerm_resource_group.test"),
+				),
+			},
+			{
+				Config:      testEmulatedAzureRMResourceGroup_requiresImport(server, false),
+				ExpectError: regexp.MustCompile("already exists - to be managed via Terraform this resource needs to be imported into the State"),
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMResourceGroup_adoptExisting(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMResourceGroup(server, "production"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, "azurerm_resource_group.test"),
+				),
+			},
+			{
+				Config: testEmulatedAzureRMResourceGroup_requiresImport(server, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("azurerm_resource_group.import", "id", "azurerm_resource_group.test", "id"),
+					resource.TestCheckResourceAttr("azurerm_resource_group.import", "tags.environment", "production"),
+				),
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMResourceGroup_preventDeletionIfContainsResources(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	// the Resource Group is checked again for a while before refusing to delete it
+	timeout := resourceGroupIsEmptyTimeout
+	resourceGroupIsEmptyTimeout = time.Second
+	defer func() { resourceGroupIsEmptyTimeout = timeout }()
+
+	untrackedId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example-resources/providers/Microsoft.Network/virtualNetworks/untracked-network", emulator.SubscriptionID)
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMResourceGroup_preventDeletionIfContainsResources(server),
+				Check: func(s *terraform.State) error {
+					return server.Create(untrackedId, map[string]interface{}{
+						"location": "westeurope",
+					})
+				},
+			},
+			{
+				Config:      testEmulatedAzureRMResourceGroup_preventDeletionIfContainsResources(server),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Unable to delete Resource Group "example-resources" since it contains 1 Resource\(s\) which aren't managed by Terraform`),
+			},
+			{
+				// once the untracked resource has been deleted, the Resource Group can be deleted
+				PreConfig: func() {
+					if err := server.Delete(untrackedId); err != nil {
+						t.Fatalf("Error deleting %q: %+v", untrackedId, err)
+					}
+				},
+				Config: testEmulatedAzureRMResourceGroup_preventDeletionIfContainsResources(server),
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMResourceGroup_preventDeletionIfContainsRecentlyDeletedResources(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	timeout := resourceGroupIsEmptyTimeout
+	resourceGroupIsEmptyTimeout = 30 * time.Second
+	defer func() { resourceGroupIsEmptyTimeout = timeout }()
+
+	untrackedId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example-resources/providers/Microsoft.Network/virtualNetworks/untracked-network", emulator.SubscriptionID)
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMResourceGroup_preventDeletionIfContainsResources(server),
+				Check: func(s *terraform.State) error {
+					return server.Create(untrackedId, map[string]interface{}{
+						"location": "westeurope",
+					})
+				},
+			},
+			{
+				// the listing of the Resources within a Resource Group lags behind those which have been deleted
+				PreConfig: func() {
+					time.AfterFunc(time.Second, func() {
+						if err := server.Delete(untrackedId); err != nil {
+							t.Errorf("Error deleting %q: %+v", untrackedId, err)
+						}
+					})
+				},
+				Config:  testEmulatedAzureRMResourceGroup_preventDeletionIfContainsResources(server),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMResourceGroup_invalidLocation(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config:      testEmulatedAzureRMResourceGroup_location(server, "westeurpoe"),
+				ExpectError: regexp.MustCompile(`the location "westeurpoe" isn't available for Subscription "` + emulator.SubscriptionID + `" - did you mean "westeurope"\?`),
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMSqlServer_nameUnavailable(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMSqlServer_basic(server),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, "azurerm_sql_server.test"),
+				),
+			},
+			{
+				Config:      testEmulatedAzureRMSqlServer_nameUnavailable(server),
+				ExpectError: regexp.MustCompile(`the name "example-sqlserver" isn't available: The name 'example-sqlserver' is already in use.`),
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMSqlServer_replacedWithSameName(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resourceName := "azurerm_sql_server.test"
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMSqlServer_administratorLogin(server, "mradministrator"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, resourceName),
+				),
+			},
+			{
+				Config: testEmulatedAzureRMSqlServer_administratorLogin(server, "msadministrator"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "example-sqlserver"),
+					resource.TestCheckResourceAttr(resourceName, "administrator_login", "msadministrator"),
+				),
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMSqlDatabase_deletionProtection(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resourceName := "azurerm_sql_database.test"
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMSqlDatabase_deletionProtection(server, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testEmulatedAzureRMSqlDatabase_deletionProtection(server, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Unable to delete SQL Database \"example-database\" since `deletion_protection` is enabled"),
+			},
+			{
+				Config: testEmulatedAzureRMSqlDatabase_deletionProtection(server, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestEmulatedDataSourceAzureRMResources_basic(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	dataSourceName := "data.azurerm_resources.test"
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedDataSourceAzureRMResources_template(server),
+			},
+			{
+				Config: testEmulatedDataSourceAzureRMResources_basic(server),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.id", "azurerm_virtual_network.hub", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.name", "hub-network"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.type", "Microsoft.Network/virtualNetworks"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.location", "westeurope"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.hub", "true"),
+					resource.TestCheckResourceAttr("data.azurerm_resources.all", "resources.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMVirtualNetwork_subnet(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMVirtualNetwork_subnet(server),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, "azurerm_virtual_network.test"),
+					testCheckEmulatedResourceExists(server, "azurerm_subnet.test"),
+					resource.TestCheckResourceAttr("azurerm_virtual_network.test", "address_space.0", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr("azurerm_subnet.test", "address_prefix", "10.0.2.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMVirtualNetwork_resumesInterruptedCreation(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resourceName := "azurerm_virtual_network.test"
+	requestsBeforeResuming := 0
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMResourceGroup(server, "production"),
+			},
+			{
+				PreConfig: func() {
+					server.SetOperationsInProgress(true)
+				},
+				Config:      testEmulatedAzureRMVirtualNetwork_interrupted(server),
+				ExpectError: regexp.MustCompile("the operation is still in progress and will be resumed on the next run"),
+			},
+			{
+				Config:      testEmulatedAzureRMVirtualNetwork_interrupted(server),
+				ExpectError: regexp.MustCompile("is still in progress and will be resumed on the next run"),
+			},
+			{
+				PreConfig: func() {
+					server.SetOperationsInProgress(false)
+					requestsBeforeResuming = len(server.Requests())
+				},
+				Config: testEmulatedAzureRMVirtualNetwork_interrupted(server),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, resourceName),
+					testCheckEmulatedResourceCreatedOnce(server, resourceName),
+					func(s *terraform.State) error {
+						for _, request := range server.Requests()[requestsBeforeResuming:] {
+							if strings.HasPrefix(request, "GET /emulator/operations/") {
+								return nil
+							}
+						}
+						return fmt.Errorf("Bad: the operation which was in progress wasn't resumed")
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMDnsARecord_basic(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMDnsARecord_basic(server),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, "azurerm_dns_zone.test"),
+					testCheckEmulatedResourceExists(server, "azurerm_dns_a_record.test"),
+					resource.TestCheckResourceAttr("azurerm_dns_zone.test", "name_servers.#", "4"),
+					resource.TestCheckResourceAttr("azurerm_dns_a_record.test", "records.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestEmulatedAzureRMLoadBalancerRule_basic(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedAzureRMLoadBalancerRule_basic(server),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(server, "azurerm_lb.test"),
+					resource.TestCheckResourceAttrPair("azurerm_lb_rule.test", "loadbalancer_id", "azurerm_lb.test", "id"),
+					resource.TestCheckResourceAttr("azurerm_lb_rule.test", "frontend_port", "3389"),
+				),
+			},
+		},
+	})
+}
+
+func testEmulator() *emulator.Server {
+	return emulator.NewServer()
+}
+
+// testEmulatedProviders returns a new instance of the Provider for each test, since the Provider is
+// configured to use the emulator the test started
+func testEmulatedProviders() map[string]terraform.ResourceProvider {
+	return map[string]terraform.ResourceProvider{
+		"azurerm": Provider(),
+	}
+}
+
+func testEmulatedProviderConfig(server *emulator.Server) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  arm_endpoint                = "%s"
+  subscription_id             = "%s"
+  tenant_id                   = "%s"
+  client_id                   = "%s"
+  client_secret               = "%s"
+  skip_credentials_validation = true
+}
+`, server.URL(), emulator.SubscriptionID, emulator.TenantID, emulator.ClientID, emulator.ClientSecret)
+}
+
+func testCheckEmulatedResourceExists(server *emulator.Server, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if _, ok := server.Resource(rs.Primary.ID); !ok {
+			return fmt.Errorf("Bad: %s (%q) does not exist in the emulator", name, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testCheckEmulatedResourceTag checks the tag is assigned to the resource within the emulator
+func testCheckEmulatedResourceTag(server *emulator.Server, name string, key string, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resource, ok := server.Resource(rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("Bad: %s (%q) does not exist in the emulator", name, rs.Primary.ID)
+		}
+
+		tags, _ := resource["tags"].(map[string]interface{})
+		if actual := tags[key]; actual != value {
+			return fmt.Errorf("Bad: expected the tag %q on %s to be %q but got %v", key, name, value, actual)
+		}
+
+		return nil
+	}
+}
+
+func testCheckEmulatedResourceDisappears(server *emulator.Server, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		return server.Delete(rs.Primary.ID)
+	}
+}
+
+// testCheckEmulatedResourceCreatedOnce checks that a single PUT request was made for the resource - e.g. that
+// an interrupted operation was resumed, rather than the resource being created again
+func testCheckEmulatedResourceCreatedOnce(server *emulator.Server, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		count := 0
+		for _, request := range server.Requests() {
+			if strings.EqualFold(request, "PUT "+rs.Primary.ID) {
+				count++
+			}
+		}
+
+		if count != 1 {
+			return fmt.Errorf("Bad: expected %s (%q) to be created once but got %d PUT requests", name, rs.Primary.ID, count)
+		}
+
+		return nil
+	}
+}
+
+func testCheckEmulatedResourcesDestroyed(server *emulator.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if ids := server.ResourceIDs(); len(ids) > 0 {
+			return fmt.Errorf("Bad: resources still exist in the emulator: %+v", ids)
+		}
+
+		return nil
+	}
+}
+
+func testEmulatedAzureRMResource_basic(server *emulator.Server, addressPrefix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource" "test" {
+  name        = "example-network"
+  parent_id   = "${azurerm_resource_group.test.id}"
+  type        = "Microsoft.Network/virtualNetworks"
+  api_version = "2018-04-01"
+
+  body = <<BODY
+{
+  "location": "${azurerm_resource_group.test.location}",
+  "properties": {
+    "addressSpace": {
+      "addressPrefixes": ["%s"]
+    }
+  }
+}
+BODY
+
+  response_export_values = ["properties.provisioningState"]
+}
+`, testEmulatedAzureRMResourceGroup(server, "production"), addressPrefix)
+}
+
+func testEmulatedAzureRMResource_requiresImport(server *emulator.Server) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource" "import" {
+  name        = "${azurerm_resource.test.name}"
+  parent_id   = "${azurerm_resource.test.parent_id}"
+  type        = "${azurerm_resource.test.type}"
+  api_version = "${azurerm_resource.test.api_version}"
+  body        = "${azurerm_resource.test.body}"
+}
+`, testEmulatedAzureRMResource_basic(server, "10.0.0.0/16"))
+}
+
+func testEmulatedAzureRMResourceGroup(server *emulator.Server, environment string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group" "test" {
+  name     = "example-resources"
+  location = "West Europe"
+
+  tags {
+    environment = "%s"
+  }
+}
+`, testEmulatedProviderConfig(server), environment)
+}
+
+func testEmulatedAzureRMResourceGroup_defaultTags(server *emulator.Server, defaultTags string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  arm_endpoint                = "%s"
+  subscription_id             = "%s"
+  tenant_id                   = "%s"
+  client_id                   = "%s"
+  client_secret               = "%s"
+  skip_credentials_validation = true
+
+  default_tags {
+    tags {
+      %s
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "example-resources"
+  location = "West Europe"
+
+  tags {
+    environment = "production"
+  }
+}
+`, server.URL(), emulator.SubscriptionID, emulator.TenantID, emulator.ClientID, emulator.ClientSecret, defaultTags)
+}
+
+func testEmulatedAzureRMResourceGroup_requiresImport(server *emulator.Server, adoptExistingResources bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  arm_endpoint                = "%s"
+  subscription_id             = "%s"
+  tenant_id                   = "%s"
+  client_id                   = "%s"
+  client_secret               = "%s"
+  skip_credentials_validation = true
+  adopt_existing_resources    = %t
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "example-resources"
+  location = "West Europe"
+
+  tags {
+    environment = "production"
+  }
+}
+
+resource "azurerm_resource_group" "import" {
+  name     = "${azurerm_resource_group.test.name}"
+  location = "${azurerm_resource_group.test.location}"
+
+  tags {
+    environment = "production"
+  }
+}
+`, server.URL(), emulator.SubscriptionID, emulator.TenantID, emulator.ClientID, emulator.ClientSecret, adoptExistingResources)
+}
+
+func testEmulatedAzureRMResourceGroup_location(server *emulator.Server, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group" "test" {
+  name     = "example-resources"
+  location = "%s"
+}
+`, testEmulatedProviderConfig(server), location)
+}
+
+func testEmulatedAzureRMResourceGroup_preventDeletionIfContainsResources(server *emulator.Server) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  arm_endpoint                                          = "%s"
+  subscription_id                                       = "%s"
+  tenant_id                                             = "%s"
+  client_id                                             = "%s"
+  client_secret                                         = "%s"
+  skip_credentials_validation                           = true
+  prevent_resource_group_deletion_if_contains_resources = true
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "example-resources"
+  location = "West Europe"
+}
+`, server.URL(), emulator.SubscriptionID, emulator.TenantID, emulator.ClientID, emulator.ClientSecret)
+}
+
+func testEmulatedAzureRMSqlServer_basic(server *emulator.Server) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group" "test" {
+  name     = "example-resources"
+  location = "West Europe"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "example-sqlserver"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+`, testEmulatedProviderConfig(server))
+}
+
+func testEmulatedAzureRMSqlServer_administratorLogin(server *emulator.Server, administratorLogin string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group" "test" {
+  name     = "example-resources"
+  location = "West Europe"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "example-sqlserver"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "%s"
+  administrator_login_password = "thisIsDog11"
+}
+`, testEmulatedProviderConfig(server), administratorLogin)
+}
+
+func testEmulatedAzureRMSqlServer_nameUnavailable(server *emulator.Server) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group" "other" {
+  name     = "other-resources"
+  location = "West Europe"
+}
+
+resource "azurerm_sql_server" "other" {
+  name                         = "example-sqlserver"
+  resource_group_name          = "${azurerm_resource_group.other.name}"
+  location                     = "${azurerm_resource_group.other.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+`, testEmulatedAzureRMSqlServer_basic(server))
+}
+
+func testEmulatedAzureRMSqlDatabase_deletionProtection(server *emulator.Server, deletionProtection bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_database" "test" {
+  name                             = "example-database"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+  deletion_protection              = %t
+}
+`, testEmulatedAzureRMSqlServer_basic(server), deletionProtection)
+}
+
+func testEmulatedDataSourceAzureRMResources_basic(server *emulator.Server) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  type                = "Microsoft.Network/virtualNetworks"
+  tag_name            = "hub"
+  tag_value           = "true"
+}
+
+data "azurerm_resources" "all" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, testEmulatedDataSourceAzureRMResources_template(server))
+}
+
+func testEmulatedDataSourceAzureRMResources_template(server *emulator.Server) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "hub" {
+  name                = "hub-network"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags {
+    hub = "true"
+  }
+}
+
+resource "azurerm_virtual_network" "spoke" {
+  name                = "spoke-network"
+  address_space       = ["10.1.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_public_ip" "hub" {
+  name                         = "hub-public-ip"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+
+  tags {
+    hub = "true"
+  }
+}
+`, testEmulatedAzureRMResourceGroup(server, "production"))
+}
+
+func testEmulatedAzureRMVirtualNetwork_subnet(server *emulator.Server) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group" "test" {
+  name     = "example-resources"
+  location = "West Europe"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "example-network"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+`, testEmulatedProviderConfig(server))
+}
+
+func testEmulatedAzureRMVirtualNetwork_interrupted(server *emulator.Server) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "example-network"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  timeouts {
+    create = "1s"
+    read   = "1s"
+  }
+}
+`, testEmulatedAzureRMResourceGroup(server, "production"))
+}
+
+func testEmulatedAzureRMDnsARecord_basic(server *emulator.Server) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group" "test" {
+  name     = "example-resources"
+  location = "West Europe"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "example.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dns_a_record" "test" {
+  name                = "www"
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  ttl                 = 300
+  records             = ["1.2.3.4", "1.2.4.5"]
+}
+`, testEmulatedProviderConfig(server))
+}
+
+func testEmulatedAzureRMLoadBalancerRule_basic(server *emulator.Server) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group" "test" {
+  name     = "example-resources"
+  location = "West Europe"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "example-ip"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "example-lb"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  frontend_ip_configuration {
+    name                 = "public"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+}
+
+resource "azurerm_lb_rule" "test" {
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  loadbalancer_id                = "${azurerm_lb.test.id}"
+  name                           = "rdp"
+  protocol                       = "Tcp"
+  frontend_port                  = 3389
+  backend_port                   = 3389
+  frontend_ip_configuration_name = "public"
+}
+`, testEmulatedProviderConfig(server))
+}
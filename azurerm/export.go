@@ -0,0 +1,520 @@
+package azurerm
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// exportResourceTypes maps the (lower-cased) type of a resource in Azure to the Terraform resource which manages it.
+// Resources of any other type are exported as an `azurerm_resource`.
+var exportResourceTypes = map[string]string{
+	"microsoft.automation/automationaccounts":                  "azurerm_automation_account",
+	"microsoft.automation/automationaccounts/runbooks":         "azurerm_automation_runbook",
+	"microsoft.cache/redis":                                    "azurerm_redis_cache",
+	"microsoft.cdn/profiles":                                   "azurerm_cdn_profile",
+	"microsoft.compute/availabilitysets":                       "azurerm_availability_set",
+	"microsoft.compute/disks":                                  "azurerm_managed_disk",
+	"microsoft.compute/images":                                 "azurerm_image",
+	"microsoft.compute/snapshots":                              "azurerm_snapshot",
+	"microsoft.compute/virtualmachines":                        "azurerm_virtual_machine",
+	"microsoft.compute/virtualmachines/extensions":             "azurerm_virtual_machine_extension",
+	"microsoft.compute/virtualmachinescalesets":                "azurerm_virtual_machine_scale_set",
+	"microsoft.containerinstance/containergroups":              "azurerm_container_group",
+	"microsoft.containerregistry/registries":                   "azurerm_container_registry",
+	"microsoft.containerservice/managedclusters":               "azurerm_kubernetes_cluster",
+	"microsoft.dbformysql/servers":                             "azurerm_mysql_server",
+	"microsoft.dbforpostgresql/servers":                        "azurerm_postgresql_server",
+	"microsoft.documentdb/databaseaccounts":                    "azurerm_cosmosdb_account",
+	"microsoft.eventgrid/topics":                               "azurerm_eventgrid_topic",
+	"microsoft.eventhub/namespaces":                            "azurerm_eventhub_namespace",
+	"microsoft.insights/components":                            "azurerm_application_insights",
+	"microsoft.keyvault/vaults":                                "azurerm_key_vault",
+	"microsoft.network/applicationgateways":                    "azurerm_application_gateway",
+	"microsoft.network/connections":                            "azurerm_virtual_network_gateway_connection",
+	"microsoft.network/dnszones":                               "azurerm_dns_zone",
+	"microsoft.network/expressroutecircuits":                   "azurerm_express_route_circuit",
+	"microsoft.network/loadbalancers":                          "azurerm_lb",
+	"microsoft.network/localnetworkgateways":                   "azurerm_local_network_gateway",
+	"microsoft.network/networkinterfaces":                      "azurerm_network_interface",
+	"microsoft.network/networksecuritygroups":                  "azurerm_network_security_group",
+	"microsoft.network/networkwatchers":                        "azurerm_network_watcher",
+	"microsoft.network/publicipaddresses":                      "azurerm_public_ip",
+	"microsoft.network/routetables":                            "azurerm_route_table",
+	"microsoft.network/trafficmanagerprofiles":                 "azurerm_traffic_manager_profile",
+	"microsoft.network/virtualnetworkgateways":                 "azurerm_virtual_network_gateway",
+	"microsoft.network/virtualnetworks":                        "azurerm_virtual_network",
+	"microsoft.network/virtualnetworks/virtualnetworkpeerings": "azurerm_virtual_network_peering",
+	"microsoft.operationalinsights/workspaces":                 "azurerm_log_analytics_workspace",
+	"microsoft.search/searchservices":                          "azurerm_search_service",
+	"microsoft.servicebus/namespaces":                          "azurerm_servicebus_namespace",
+	"microsoft.sql/servers":                                    "azurerm_sql_server",
+	"microsoft.sql/servers/databases":                          "azurerm_sql_database",
+	"microsoft.sql/servers/elasticpools":                       "azurerm_sql_elasticpool",
+	"microsoft.storage/storageaccounts":                        "azurerm_storage_account",
+	"microsoft.web/serverfarms":                                "azurerm_app_service_plan",
+	"microsoft.web/sites":                                      "azurerm_app_service",
+}
+
+// exportedResource is a resource within the Resource Group which is being exported
+type exportedResource struct {
+	resourceType string
+	name         string
+	id           string
+
+	// address is the resource's address within the configuration, e.g. `azurerm_virtual_network.example`
+	address string
+	state   *terraform.InstanceState
+}
+
+// exportReferences are the interpolations which replace the name of the Resource Group and the IDs of the
+// resources being exported, such that Terraform creates (and deletes) them in the correct order
+type exportReferences struct {
+	resourceGroupName    string
+	resourceGroupAddress string
+
+	// addresses is the address of each resource, by its (lower-cased) ID
+	addresses map[string]string
+
+	// self is the ID of the resource being written, which can't reference itself
+	self string
+}
+
+// ExportResourceGroup writes the configuration for the Resource Group and each of the resources within it to
+// config, and a `terraform import` command for each of them to imports - such that existing infrastructure can be
+// brought under the management of Terraform. Resources which can't be exported are listed in a comment at the end
+// of the configuration, rather than failing the export. Credentials are sourced from the environment (or the Azure
+// CLI) in the same way as when the provider is configured without any arguments.
+func ExportResourceGroup(resourceGroup string, config io.Writer, imports io.Writer) error {
+	p := Provider().(*armProvider)
+	if err := p.Configure(terraform.NewResourceConfig(nil)); err != nil {
+		return fmt.Errorf("Error configuring the Azure Provider: %+v", err)
+	}
+
+	return p.exportResourceGroup(resourceGroup, config, imports)
+}
+
+func (p *armProvider) exportResourceGroup(resourceGroup string, config io.Writer, imports io.Writer) error {
+	client := p.Meta().(*ArmClient)
+	ctx := client.StopContext
+
+	group, err := client.resources().resourceGroupsClient.Get(ctx, resourceGroup)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Resource Group %q: %+v", resourceGroup, err)
+	}
+
+	candidates := []exportedResource{
+		{
+			resourceType: "azurerm_resource_group",
+			name:         *group.Name,
+			id:           *group.ID,
+		},
+	}
+
+	iterator, err := client.resources().resourcesClient.ListByResourceGroupComplete(ctx, resourceGroup, "", "", nil)
+	if err != nil {
+		return fmt.Errorf("Error listing the Resources within Resource Group %q: %+v", resourceGroup, err)
+	}
+	for iterator.NotDone() {
+		resource := iterator.Value()
+		if resource.ID != nil && resource.Name != nil && resource.Type != nil {
+			candidates = append(candidates, exportedResource{
+				resourceType: p.exportResourceType(resource),
+				name:         *resource.Name,
+				id:           *resource.ID,
+			})
+		}
+
+		if err := iterator.Next(); err != nil {
+			return fmt.Errorf("Error listing the Resources within Resource Group %q: %+v", resourceGroup, err)
+		}
+	}
+
+	references := exportReferences{
+		resourceGroupName: *group.Name,
+		addresses:         make(map[string]string),
+	}
+	exports := make([]exportedResource, 0, len(candidates))
+	skipped := make([]string, 0)
+	names := make(map[string]struct{})
+	for _, export := range candidates {
+		export.address = fmt.Sprintf("%s.%s", export.resourceType, exportResourceName(export.resourceType, export.name, names))
+		export.state, err = p.exportResourceState(export.info(), export.id)
+
+		// the Resource Group is referenced by every other resource, but other resources which can't be imported
+		// (or refreshed) as the Terraform resource which manages them can still be exported as an `azurerm_resource`
+		if err != nil && export.resourceType != "azurerm_resource_group" && export.resourceType != "azurerm_resource" {
+			log.Printf("[WARN] Unable to export %q as %s - exporting it as an `azurerm_resource` instead: %+v", export.id, export.address, err)
+			export.resourceType = "azurerm_resource"
+			export.address = fmt.Sprintf("%s.%s", export.resourceType, exportResourceName(export.resourceType, export.name, names))
+			export.state, err = p.exportResourceState(export.info(), export.id)
+		}
+		if err != nil {
+			if export.resourceType == "azurerm_resource_group" {
+				return fmt.Errorf("Error exporting %q as %s: %+v", export.id, export.address, err)
+			}
+
+			log.Printf("[WARN] Unable to export %q as %s - skipping: %+v", export.id, export.address, err)
+			skipped = append(skipped, exportSkippedComment(export.id, err))
+			continue
+		}
+		if export.state == nil {
+			log.Printf("[DEBUG] %q no longer exists - skipping", export.id)
+			continue
+		}
+
+		if export.resourceType == "azurerm_resource_group" {
+			references.resourceGroupAddress = export.address
+		}
+		references.addresses[strings.ToLower(export.id)] = export.address
+		exports = append(exports, export)
+	}
+
+	for i, export := range exports {
+		resource := p.ResourcesMap[export.resourceType]
+		references.self = export.id
+
+		var buf bytes.Buffer
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		parts := strings.SplitN(export.address, ".", 2)
+		fmt.Fprintf(&buf, "resource %q %q {\n", parts[0], parts[1])
+		references.writeBlock(&buf, resource.Schema, exportResourceValues(resource, export.state), "  ")
+		buf.WriteString("}\n")
+
+		if _, err := buf.WriteTo(config); err != nil {
+			return fmt.Errorf("Error writing the configuration for %s: %+v", export.address, err)
+		}
+
+		if _, err := fmt.Fprintf(imports, "terraform import %s %s\n", export.address, exportShellQuote(export.id)); err != nil {
+			return fmt.Errorf("Error writing the import command for %s: %+v", export.address, err)
+		}
+	}
+
+	if len(skipped) > 0 {
+		var buf bytes.Buffer
+		buf.WriteString("\n# The following resources couldn't be exported, and need to be added manually:\n")
+		for _, comment := range skipped {
+			buf.WriteString(comment)
+		}
+
+		if _, err := buf.WriteTo(config); err != nil {
+			return fmt.Errorf("Error writing the resources which couldn't be exported: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func (e exportedResource) info() *terraform.InstanceInfo {
+	return &terraform.InstanceInfo{
+		Id:   e.address,
+		Type: e.resourceType,
+	}
+}
+
+// exportSkippedComment returns a comment listing a resource which couldn't be exported, and why
+func exportSkippedComment(id string, err error) string {
+	reason := strings.Join(strings.Fields(err.Error()), " ")
+	return fmt.Sprintf("#   %s\n#     %s\n", id, reason)
+}
+
+// exportResourceType returns the Terraform resource which manages the resource, falling back to the generic
+// `azurerm_resource` where there isn't one (or it can't be imported)
+func (p *armProvider) exportResourceType(resource resources.GenericResource) string {
+	resourceType := strings.ToLower(*resource.Type)
+
+	// Function Apps are App Services of a different kind
+	if resourceType == "microsoft.web/sites" && resource.Kind != nil && strings.Contains(strings.ToLower(*resource.Kind), "functionapp") {
+		return "azurerm_function_app"
+	}
+
+	if v, ok := exportResourceTypes[resourceType]; ok {
+		if r, ok := p.ResourcesMap[v]; ok && r.Importer != nil {
+			return v
+		}
+	}
+
+	return "azurerm_resource"
+}
+
+// exportResourceState imports the resource with the specified ID and then refreshes it, returning nil if the
+// resource no longer exists
+func (p *armProvider) exportResourceState(info *terraform.InstanceInfo, id string) (*terraform.InstanceState, error) {
+	states, err := p.ImportState(info, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// some importers return other resources in addition to the resource itself, which aren't exported
+	for _, state := range states {
+		if state.Ephemeral.Type == "" || state.Ephemeral.Type == info.Type {
+			return p.Refresh(info, state)
+		}
+	}
+
+	return nil, nil
+}
+
+// exportResourceValues returns the value of each of the arguments of the resource from its state
+func exportResourceValues(resource *schema.Resource, state *terraform.InstanceState) map[string]interface{} {
+	d := resource.Data(state)
+
+	values := make(map[string]interface{})
+	for key := range resource.Schema {
+		values[key] = d.Get(key)
+	}
+
+	return values
+}
+
+// writeBlock writes the arguments within a block - attributes first (aligned, as `terraform fmt` does)
+// followed by the nested blocks
+func (r exportReferences) writeBlock(buf *bytes.Buffer, s map[string]*schema.Schema, values map[string]interface{}, indent string) {
+	attributes := make([]string, 0)
+	blocks := make([]string, 0)
+	for _, key := range exportArgumentOrder(s) {
+		if !exportArgument(s[key], values[key]) {
+			continue
+		}
+
+		if _, ok := s[key].Elem.(*schema.Resource); ok || s[key].Type == schema.TypeMap {
+			blocks = append(blocks, key)
+		} else {
+			attributes = append(attributes, key)
+		}
+	}
+
+	width := 0
+	for _, key := range attributes {
+		if len(key) > width {
+			width = len(key)
+		}
+	}
+	for _, key := range attributes {
+		fmt.Fprintf(buf, "%s%-*s = %s\n", indent, width, key, r.value(key, values[key]))
+	}
+
+	for _, key := range blocks {
+		if s[key].Type == schema.TypeMap {
+			buf.WriteString("\n")
+			r.writeMap(buf, key, values[key].(map[string]interface{}), indent)
+			continue
+		}
+
+		elem := s[key].Elem.(*schema.Resource)
+		for _, item := range exportList(values[key]) {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			fmt.Fprintf(buf, "\n%s%s {\n", indent, key)
+			r.writeBlock(buf, elem.Schema, m, indent+"  ")
+			fmt.Fprintf(buf, "%s}\n", indent)
+		}
+	}
+}
+
+func (r exportReferences) writeMap(buf *bytes.Buffer, key string, values map[string]interface{}, indent string) {
+	keys := make([]string, 0, len(values))
+	width := 0
+	for k := range values {
+		keys = append(keys, k)
+		if len(exportMapKey(k)) > width {
+			width = len(exportMapKey(k))
+		}
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintf(buf, "%s%s {\n", indent, key)
+	for _, k := range keys {
+		fmt.Fprintf(buf, "%s  %-*s = %s\n", indent, width, exportMapKey(k), r.value(k, values[k]))
+	}
+	fmt.Fprintf(buf, "%s}\n", indent)
+}
+
+// exportArgumentOrder returns the names of the arguments in the order they're written, which is the name,
+// Resource Group and location (as in the documentation) followed by the remaining arguments alphabetically
+func exportArgumentOrder(s map[string]*schema.Schema) []string {
+	first := []string{"name", "resource_group_name", "location"}
+
+	keys := make([]string, 0, len(s))
+	for _, key := range first {
+		if _, ok := s[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+
+	remaining := make([]string, 0, len(s))
+	for key := range s {
+		if key != "name" && key != "resource_group_name" && key != "location" {
+			remaining = append(remaining, key)
+		}
+	}
+	sort.Strings(remaining)
+
+	return append(keys, remaining...)
+}
+
+// exportArgument returns whether the argument should be written - which excludes computed-only and deprecated
+// attributes, and optional arguments which are unset (or set to their default value)
+func exportArgument(s *schema.Schema, value interface{}) bool {
+	if !s.Required && !s.Optional {
+		return false
+	}
+
+	if s.Deprecated != "" || s.Removed != "" {
+		return false
+	}
+
+	if s.Required {
+		return true
+	}
+
+	if s.Default != nil {
+		return !reflect.DeepEqual(value, s.Default)
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case bool:
+		return v
+	case int:
+		return v != 0
+	case float64:
+		return v != 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+
+	return len(exportList(value)) > 0
+}
+
+// exportList returns the items within a List or Set
+func exportList(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+
+	return nil
+}
+
+// value returns the value of an attribute in HCL, replacing the name of the Resource Group and the IDs of the
+// other resources being exported with a reference to them
+func (r exportReferences) value(key string, value interface{}) string {
+	if v, ok := value.(string); ok {
+		if key == "resource_group_name" && r.resourceGroupAddress != "" && strings.EqualFold(v, r.resourceGroupName) {
+			return fmt.Sprintf(`"${%s.name}"`, r.resourceGroupAddress)
+		}
+
+		if address, ok := r.addresses[strings.ToLower(v)]; ok && !strings.EqualFold(v, r.self) {
+			return fmt.Sprintf(`"${%s.id}"`, address)
+		}
+
+		return exportString(v)
+	}
+
+	if list := exportList(value); list != nil {
+		items := make([]string, 0, len(list))
+		for _, item := range list {
+			items = append(items, r.value("", item))
+		}
+		return fmt.Sprintf("[%s]", strings.Join(items, ", "))
+	}
+
+	return exportValue(value)
+}
+
+// exportValue returns the value of an attribute in HCL
+func exportValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return exportString(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	items := make([]string, 0)
+	for _, item := range exportList(value) {
+		items = append(items, exportValue(item))
+	}
+	return fmt.Sprintf("[%s]", strings.Join(items, ", "))
+}
+
+// exportString quotes the value as a string in HCL, escaping anything which would otherwise be interpolated
+func exportString(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+		"${", "$${",
+	)
+	return fmt.Sprintf(`"%s"`, replacer.Replace(value))
+}
+
+var exportIdentifierRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// exportMapKey quotes the key of a map (e.g. a tag) where it's not a valid identifier
+func exportMapKey(key string) string {
+	if exportIdentifierRegex.MatchString(key) {
+		return key
+	}
+
+	return strconv.Quote(key)
+}
+
+var exportInvalidNameCharactersRegex = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// exportResourceName returns a unique name for the resource within the configuration, derived from its name in Azure
+func exportResourceName(resourceType string, name string, used map[string]struct{}) string {
+	base := strings.Trim(exportInvalidNameCharactersRegex.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = "r_" + base
+	}
+
+	result := base
+	for i := 2; ; i++ {
+		if _, ok := used[resourceType+"."+result]; !ok {
+			break
+		}
+		result = fmt.Sprintf("%s_%d", base, i)
+	}
+
+	used[resourceType+"."+result] = struct{}{}
+	return result
+}
+
+var exportShellSafeRegex = regexp.MustCompile(`^[a-zA-Z0-9/._:=@+-]+$`)
+
+// exportShellQuote quotes the Resource ID for use in a shell, where it contains characters which need quoting
+func exportShellQuote(id string) string {
+	if exportShellSafeRegex.MatchString(id) {
+		return id
+	}
+
+	return "'" + strings.Replace(id, "'", `'\''`, -1) + "'"
+}
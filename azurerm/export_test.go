@@ -0,0 +1,337 @@
+package azurerm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/emulator"
+)
+
+func TestExportResourceTypes(t *testing.T) {
+	provider := Provider().(*armProvider)
+	for armType, resourceType := range exportResourceTypes {
+		if armType != strings.ToLower(armType) {
+			t.Fatalf("Expected the type %q to be lower-case", armType)
+		}
+
+		resource, ok := provider.ResourcesMap[resourceType]
+		if !ok {
+			t.Fatalf("Expected %q (for %q) to be a registered resource", resourceType, armType)
+		}
+		if resource.Importer == nil {
+			t.Fatalf("Expected %q (for %q) to support import", resourceType, armType)
+		}
+	}
+}
+
+func TestExportResourceName(t *testing.T) {
+	used := make(map[string]struct{})
+	cases := []struct {
+		ResourceType string
+		Name         string
+		Expected     string
+	}{
+		{ResourceType: "azurerm_virtual_network", Name: "Example-Network", Expected: "example_network"},
+		{ResourceType: "azurerm_virtual_network", Name: "example.network", Expected: "example_network_2"},
+		{ResourceType: "azurerm_public_ip", Name: "example-network", Expected: "example_network"},
+		{ResourceType: "azurerm_public_ip", Name: "1-ip", Expected: "r_1_ip"},
+		{ResourceType: "azurerm_public_ip", Name: "--", Expected: "r_"},
+	}
+
+	for _, tc := range cases {
+		if actual := exportResourceName(tc.ResourceType, tc.Name, used); actual != tc.Expected {
+			t.Fatalf("Expected the name for %q (%s) to be %q but got %q", tc.Name, tc.ResourceType, tc.Expected, actual)
+		}
+	}
+}
+
+func TestExportValue(t *testing.T) {
+	cases := []struct {
+		Value    interface{}
+		Expected string
+	}{
+		{Value: "West Europe", Expected: `"West Europe"`},
+		{Value: `{"a":"${b}"}`, Expected: `"{\"a\":\"$${b}\"}"`},
+		{Value: "C:\\path\n", Expected: `"C:\\path\n"`},
+		{Value: true, Expected: "true"},
+		{Value: 10, Expected: "10"},
+		{Value: 1.5, Expected: "1.5"},
+		{Value: []interface{}{"10.0.0.0/16", "10.1.0.0/16"}, Expected: `["10.0.0.0/16", "10.1.0.0/16"]`},
+		{Value: []interface{}{}, Expected: "[]"},
+	}
+
+	for _, tc := range cases {
+		if actual := exportValue(tc.Value); actual != tc.Expected {
+			t.Fatalf("Expected %+v to be exported as %s but got %s", tc.Value, tc.Expected, actual)
+		}
+	}
+}
+
+func TestExportShellQuote(t *testing.T) {
+	cases := map[string]string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example":  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my group": "'/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my group'",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/it's":     `'/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/it'\''s'`,
+	}
+
+	for input, expected := range cases {
+		if actual := exportShellQuote(input); actual != expected {
+			t.Fatalf("Expected %q to be quoted as %s but got %s", input, expected, actual)
+		}
+	}
+}
+
+func TestEmulatedExportResourceGroup(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	var exportedConfig, exportedImports bytes.Buffer
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedExportResourceGroup(server),
+				Check: func(s *terraform.State) error {
+					provider, err := testEmulatedConfiguredProvider(server)
+					if err != nil {
+						return err
+					}
+
+					return provider.exportResourceGroup("example-resources", &exportedConfig, &exportedImports)
+				},
+			},
+		},
+	})
+
+	generated := exportedConfig.String()
+	if _, err := hcl.Parse(generated); err != nil {
+		t.Fatalf("Expected the exported configuration to be valid HCL but got %+v:\n%s", err, generated)
+	}
+
+	for _, expected := range []string{
+		`resource "azurerm_resource_group" "example_resources" {`,
+		`resource "azurerm_virtual_network" "example_network" {`,
+		`  address_space       = ["10.0.0.0/16"]`,
+		`    address_prefix = "10.0.1.0/24"`,
+		`resource "azurerm_public_ip" "example_ip" {`,
+		`    "hidden-link:owner" = "platform"`,
+		`resource "azurerm_resource" "example_widget" {`,
+		`  api_version = "2018-01-01"`,
+		`  parent_id   = "${azurerm_resource_group.example_resources.id}"`,
+		`  resource_group_name = "${azurerm_resource_group.example_resources.name}"`,
+	} {
+		if !strings.Contains(generated, expected) {
+			t.Fatalf("Expected the exported configuration to contain %q:\n%s", expected, generated)
+		}
+	}
+
+	imports := strings.Split(strings.TrimSpace(exportedImports.String()), "\n")
+	if len(imports) != 4 {
+		t.Fatalf("Expected 4 import commands but got %d:\n%s", len(imports), exportedImports.String())
+	}
+	for _, expected := range []string{
+		"terraform import azurerm_resource_group.example_resources /subscriptions/",
+		"terraform import azurerm_resource.example_widget /subscriptions/" + emulator.SubscriptionID + "/resourceGroups/example-resources/providers/Microsoft.Example/widgets/example-widget",
+	} {
+		// Resource IDs are case-insensitive
+		if !strings.Contains(strings.ToLower(exportedImports.String()), strings.ToLower(expected)) {
+			t.Fatalf("Expected the import commands to contain %q:\n%s", expected, exportedImports.String())
+		}
+	}
+
+	// the exported configuration should create the same resources, without any further changes being planned
+	replica := testEmulator()
+	defer replica.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(replica),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf("%s\n%s", testEmulatedProviderConfig(replica), generated),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckEmulatedResourceExists(replica, "azurerm_virtual_network.example_network"),
+					testCheckEmulatedResourceExists(replica, "azurerm_resource.example_widget"),
+				),
+			},
+		},
+	})
+}
+
+func TestEmulatedExportResourceGroup_resourcesWhichCantBeImported(t *testing.T) {
+	server := testEmulator()
+	defer server.Close()
+
+	storageAccountId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example-resources/providers/Microsoft.Storage/storageAccounts/examplestorage", emulator.SubscriptionID)
+	gadgetId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example-resources/providers/Microsoft.Example/gadgets/example-gadget", emulator.SubscriptionID)
+	storageAccount := map[string]interface{}{
+		"location": "westeurope",
+		"kind":     "Storage",
+		"sku": map[string]interface{}{
+			"name": "Standard_LRS",
+		},
+	}
+
+	var exportedConfig, exportedImports bytes.Buffer
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testEmulatedProviders(),
+		CheckDestroy: testCheckEmulatedResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testEmulatedExportResourceGroup(server),
+				Check: func(s *terraform.State) error {
+					// the emulator doesn't support listing the keys of a Storage Account, which the
+					// `azurerm_storage_account` resource retrieves when it's refreshed - as such it's exported as an
+					// `azurerm_resource`, using the API version it was created with
+					body, _ := json.Marshal(storageAccount)
+					req, _ := http.NewRequest(http.MethodPut, server.URL()+storageAccountId+"?api-version=2017-10-01", bytes.NewReader(body))
+					resp, err := http.DefaultClient.Do(req)
+					if err != nil {
+						return err
+					}
+					resp.Body.Close()
+
+					// whereas no API version is published for a resource type which has only been created directly in
+					// the emulator, so it can't be exported at all
+					if err := server.Create(gadgetId, map[string]interface{}{"location": "westeurope"}); err != nil {
+						return err
+					}
+
+					provider, err := testEmulatedConfiguredProvider(server)
+					if err != nil {
+						return err
+					}
+
+					if err := provider.exportResourceGroup("example-resources", &exportedConfig, &exportedImports); err != nil {
+						return err
+					}
+
+					for _, id := range []string{storageAccountId, gadgetId} {
+						if err := server.Delete(id); err != nil {
+							return err
+						}
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+
+	generated := exportedConfig.String()
+	if _, err := hcl.Parse(generated); err != nil {
+		t.Fatalf("Expected the exported configuration to be valid HCL but got %+v:\n%s", err, generated)
+	}
+
+	for _, expected := range []string{
+		`resource "azurerm_virtual_network" "example_network" {`,
+		`resource "azurerm_resource" "examplestorage" {`,
+		"#   " + gadgetId + "\n",
+	} {
+		if !strings.Contains(generated, expected) {
+			t.Fatalf("Expected the exported configuration to contain %q:\n%s", expected, generated)
+		}
+	}
+
+	if !strings.Contains(exportedImports.String(), "terraform import azurerm_resource.examplestorage ") {
+		t.Fatalf("Expected the Storage Account to be imported as an `azurerm_resource`:\n%s", exportedImports.String())
+	}
+	if strings.Contains(exportedImports.String(), "example-gadget") {
+		t.Fatalf("Expected the resource which couldn't be exported not to be imported:\n%s", exportedImports.String())
+	}
+}
+
+func TestExportSkippedComment(t *testing.T) {
+	id := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Example/widgets/example"
+	err := fmt.Errorf("1 error(s) occurred:\n\n* Error retrieving the Widget:   access denied")
+
+	expected := "#   " + id + "\n#     1 error(s) occurred: * Error retrieving the Widget: access denied\n"
+	if actual := exportSkippedComment(id, err); actual != expected {
+		t.Fatalf("Expected the comment to be %q but got %q", expected, actual)
+	}
+
+	if _, err := hcl.Parse(expected); err != nil {
+		t.Fatalf("Expected the comment to be valid HCL but got %+v", err)
+	}
+}
+
+// testEmulatedConfiguredProvider returns an instance of the Provider which is configured to use the emulator
+func testEmulatedConfiguredProvider(server *emulator.Server) (*armProvider, error) {
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"arm_endpoint":                server.URL(),
+		"subscription_id":             emulator.SubscriptionID,
+		"tenant_id":                   emulator.TenantID,
+		"client_id":                   emulator.ClientID,
+		"client_secret":               emulator.ClientSecret,
+		"skip_credentials_validation": true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	provider := Provider().(*armProvider)
+	if err := provider.Configure(terraform.NewResourceConfig(raw)); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+func testEmulatedExportResourceGroup(server *emulator.Server) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group" "test" {
+  name     = "example-resources"
+  location = "West Europe"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "example-network"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  subnet {
+    name           = "internal"
+    address_prefix = "10.0.1.0/24"
+  }
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "example-ip"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+
+  tags {
+    "hidden-link:owner" = "platform"
+  }
+}
+
+resource "azurerm_resource" "test" {
+  name        = "example-widget"
+  parent_id   = "${azurerm_resource_group.test.id}"
+  type        = "Microsoft.Example/widgets"
+  api_version = "2018-01-01"
+
+  body = <<BODY
+{
+  "location": "${azurerm_resource_group.test.location}",
+  "properties": {
+    "size": 3
+  }
+}
+BODY
+}
+`, testEmulatedProviderConfig(server))
+}
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
@@ -9,20 +10,19 @@ import (
 )
 
 func extractResourceGroupAndErcName(resourceId string) (resourceGroup string, name string, err error) {
-	id, err := parseAzureResourceID(resourceId)
+	id, err := parseExpressRouteCircuitID(resourceId)
 
 	if err != nil {
 		return "", "", err
 	}
 	resourceGroup = id.ResourceGroup
-	name = id.Path["expressRouteCircuits"]
+	name = id.Name
 
 	return
 }
 
-func retrieveErcByResourceId(resourceId string, meta interface{}) (erc *network.ExpressRouteCircuit, resourceGroup string, e error) {
-	ercClient := meta.(*ArmClient).expressRouteCircuitClient
-	ctx := meta.(*ArmClient).StopContext
+func retrieveErcByResourceId(ctx context.Context, resourceId string, meta interface{}) (erc *network.ExpressRouteCircuit, resourceGroup string, e error) {
+	ercClient := meta.(*ArmClient).networking().expressRouteCircuitClient
 
 	resGroup, name, err := extractResourceGroupAndErcName(resourceId)
 	if err != nil {
@@ -2,14 +2,19 @@ package authentication
 
 import (
 	"strings"
+	"time"
 
 	"fmt"
+	"log"
 
 	"github.com/Azure/go-autorest/autorest/azure/cli"
 )
 
 type AzureCLIProfile struct {
 	cli.Profile
+
+	// the tokens from the Azure CLI's `accessTokens.json`
+	tokens []cli.Token
 }
 
 func (a AzureCLIProfile) FindDefaultSubscriptionId() (string, error) {
@@ -31,3 +36,54 @@ func (a AzureCLIProfile) FindSubscription(subscriptionId string) (*cli.Subscript
 
 	return nil, fmt.Errorf("Subscription %q was not found in your Azure CLI credentials. Please verify it exists in `az account list`.", subscriptionId)
 }
+
+// FindRefreshTokenForTenant returns the most recently issued token from the Azure CLI for the specified Tenant
+// which contains a Refresh Token - which can be used to obtain new Access Tokens once the current ones expire.
+func (a AzureCLIProfile) FindRefreshTokenForTenant(tenantId string) (*AccessToken, error) {
+	var latest *cli.Token
+	var latestExpiration time.Time
+
+	for i, token := range a.tokens {
+		if token.RefreshToken == "" {
+			continue
+		}
+
+		if !isAuthorityForTenant(token.Authority, tenantId) {
+			log.Printf("[DEBUG] Refresh Token for %q isn't for the correct Tenant", token.Resource)
+			continue
+		}
+
+		expirationDate, err := cli.ParseExpirationDate(token.ExpiresOn)
+		if err != nil {
+			log.Printf("[DEBUG] Error parsing expiration date %q: %+v", token.ExpiresOn, err)
+			continue
+		}
+
+		if latest == nil || expirationDate.After(latestExpiration) {
+			latest = &a.tokens[i]
+			latestExpiration = *expirationDate
+		}
+	}
+
+	if latest == nil {
+		return nil, fmt.Errorf("No Refresh Token was found for the Tenant ID %q", tenantId)
+	}
+
+	token, err := latest.ToADALToken()
+	if err != nil {
+		return nil, fmt.Errorf("Error converting the Refresh Token to a token: %+v", err)
+	}
+
+	return &AccessToken{
+		ClientID:     latest.ClientID,
+		AccessToken:  &token,
+		IsCloudShell: false,
+	}, nil
+}
+
+// isAuthorityForTenant returns whether the Authority (e.g. `https://login.microsoftonline.com/{tenantId}`)
+// is for the specified Tenant
+func isAuthorityForTenant(authority string, tenantId string) bool {
+	segments := strings.Split(strings.TrimSuffix(authority, "/"), "/")
+	return strings.EqualFold(segments[len(segments)-1], tenantId)
+}
@@ -1,7 +1,9 @@
 package authentication
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest/azure/cli"
 )
@@ -190,3 +192,100 @@ func TestAzureCLIProfileFindSubscription(t *testing.T) {
 		}
 	}
 }
+
+func TestAzureCLIProfileFindRefreshTokenForTenant(t *testing.T) {
+	tenantId := "c056adac-c6a6-4ddf-ab20-0f26d47f7eea"
+	expired := time.Now().Add(time.Hour * -2).Format("2006-01-02 15:04:05.999999")
+	recentlyExpired := time.Now().Add(time.Minute * -5).Format("2006-01-02 15:04:05.999999")
+
+	cases := []struct {
+		Description          string
+		Tokens               []cli.Token
+		ExpectedRefreshToken string
+		ExpectError          bool
+	}{
+		{
+			Description: "No Tokens",
+			Tokens:      []cli.Token{},
+			ExpectError: true,
+		},
+		{
+			Description: "No Refresh Tokens",
+			Tokens: []cli.Token{
+				{
+					Authority: "https://login.microsoftonline.com/" + tenantId,
+					ExpiresOn: expired,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Refresh Token for another Tenant",
+			Tokens: []cli.Token{
+				{
+					Authority:    "https://login.microsoftonline.com/9b46ce86-4d5d-4b5a-9e84-3d0c2e0b6cab",
+					ExpiresOn:    expired,
+					RefreshToken: "other-tenant",
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Expired Refresh Token for the Tenant",
+			Tokens: []cli.Token{
+				{
+					Authority:    "https://login.microsoftonline.com/" + tenantId,
+					ClientID:     "04b07795-8ddb-461a-bbee-02f9e1bf7b46",
+					ExpiresOn:    expired,
+					RefreshToken: "expired",
+				},
+			},
+			ExpectedRefreshToken: "expired",
+		},
+		{
+			Description: "Most Recent Refresh Token for the Tenant",
+			Tokens: []cli.Token{
+				{
+					Authority:    "https://login.microsoftonline.com/" + tenantId,
+					ExpiresOn:    expired,
+					RefreshToken: "older",
+				},
+				{
+					Authority:    "https://login.microsoftonline.com/" + strings.ToUpper(tenantId) + "/",
+					ExpiresOn:    recentlyExpired,
+					RefreshToken: "newer",
+				},
+				{
+					Authority:    "https://login.microsoftonline.com/9b46ce86-4d5d-4b5a-9e84-3d0c2e0b6cab",
+					ExpiresOn:    time.Now().Add(time.Hour).Format("2006-01-02 15:04:05.999999"),
+					RefreshToken: "other-tenant",
+				},
+			},
+			ExpectedRefreshToken: "newer",
+		},
+	}
+
+	for _, v := range cases {
+		profile := AzureCLIProfile{
+			tokens: v.Tokens,
+		}
+
+		token, err := profile.FindRefreshTokenForTenant(tenantId)
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+		}
+
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+
+		if token != nil && token.AccessToken.RefreshToken != v.ExpectedRefreshToken {
+			t.Fatalf("Expected the Refresh Token for %q to be %q - got %q", v.Description, v.ExpectedRefreshToken, token.AccessToken.RefreshToken)
+		}
+
+		if token != nil && token.IsCloudShell {
+			t.Fatalf("Expected the Refresh Token for %q not to be from CloudShell", v.Description)
+		}
+	}
+}
@@ -2,10 +2,12 @@ package authentication
 
 import (
 	"fmt"
-
 	"log"
+	"strings"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/azure/cli"
 )
 
@@ -19,15 +21,34 @@ type Config struct {
 	SubscriptionID            string
 	TenantID                  string
 	Environment               string
+	ArmEndpoint               string
 	SkipCredentialsValidation bool
 	SkipProviderRegistration  bool
 
+	// Retries
+	MaxRetries    int
+	MaxRetryDelay time.Duration
+
+	// Logging
+	DisableHttpBodyLogging bool
+
 	// Service Principal Auth
 	ClientSecret string
 
+	// Auxiliary Tenants, which Resource Manager authorizes cross-tenant operations against
+	AuxiliaryTenantIDs []string
+
+	// Service Principal (Client Certificate) Auth
+	ClientCertPath     string
+	ClientCertPassword string
+
 	// Bearer Auth
 	AccessToken  *adal.Token
 	IsCloudShell bool
+
+	// Managed Service Identity Auth
+	UseMsi      bool
+	MsiEndpoint string
 }
 
 func (c *Config) LoadTokensFromAzureCLI() error {
@@ -75,8 +96,17 @@ func (c *Config) LoadTokensFromAzureCLI() error {
 		if err != nil {
 			return fmt.Errorf("Azure CLI Authorization Tokens were not found. Please ensure the Azure CLI is installed and then log-in with `az login`.")
 		}
+		cliProfile.tokens = tokens
 
 		validToken, _ := findValidAccessTokenForTenant(tokens, c.TenantID)
+		if validToken == nil {
+			// the Access Tokens may have expired, in which case they can be refreshed using a Refresh Token
+			validToken, err = cliProfile.FindRefreshTokenForTenant(c.TenantID)
+			if err != nil {
+				// we want to expose a more friendly error to the user, but this is useful for debug purposes
+				log.Printf("Error finding a Refresh Token in the CLI Profile: %s", err)
+			}
+		}
 		if validToken != nil {
 			foundToken, err = c.populateFromAccessToken(validToken)
 			if err != nil {
@@ -86,7 +116,7 @@ func (c *Config) LoadTokensFromAzureCLI() error {
 	}
 
 	if !foundToken {
-		return fmt.Errorf("No valid (unexpired) Azure CLI Auth Tokens found. Please run `az login`.")
+		return fmt.Errorf("No valid (unexpired or refreshable) Azure CLI Auth Tokens found. Please run `az login`.")
 	}
 
 	// always pull the Environment from the CLI
@@ -144,3 +174,37 @@ func (c *Config) populateFromAccessToken(token *AccessToken) (bool, error) {
 
 	return true, nil
 }
+
+// AccessTokenForResource returns the Azure CLI Access Token to use for the specified resource (audience) within the
+// Azure Environment. Access Tokens from the Azure CLI are issued for Resource Manager - as such for other resources
+// (e.g. Graph or Key Vault) the Access Token is cleared so that one for the resource is obtained using the Refresh
+// Token when it's first used.
+func (c *Config) AccessTokenForResource(env azure.Environment, resource string) adal.Token {
+	token := *c.AccessToken
+	if token.RefreshToken == "" || strings.EqualFold(token.Resource, resource) {
+		return token
+	}
+
+	if isManagementResource(env, token.Resource) && isManagementResource(env, resource) {
+		return token
+	}
+
+	return adal.Token{
+		RefreshToken: token.RefreshToken,
+		ExpiresOn:    "0",
+		Resource:     resource,
+		Type:         token.Type,
+	}
+}
+
+// isManagementResource returns whether the resource (audience) is Resource Manager in the Azure Environment - which
+// accepts tokens issued for either its own endpoint, or the Service Management endpoint (which the Azure CLI uses)
+func isManagementResource(env azure.Environment, resource string) bool {
+	for _, endpoint := range []string{env.ResourceManagerEndpoint, env.ServiceManagementEndpoint} {
+		if endpoint != "" && strings.EqualFold(strings.TrimSuffix(endpoint, "/"), strings.TrimSuffix(resource, "/")) {
+			return true
+		}
+	}
+
+	return false
+}
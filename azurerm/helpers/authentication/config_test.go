@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/azure/cli"
 )
 
@@ -204,3 +205,109 @@ func TestAzurePopulateFromAccessToken_Exists(t *testing.T) {
 		t.Fatalf("Expected `AccessToken` to be %+v, got %+v", token.AccessToken, config.AccessToken)
 	}
 }
+
+func TestAzureAccessTokenForResource(t *testing.T) {
+	cases := []struct {
+		Description     string
+		Environment     azure.Environment
+		Token           adal.Token
+		Resource        string
+		ExpectRefreshed bool
+	}{
+		{
+			Description: "Resource Manager",
+			Environment: azure.PublicCloud,
+			Token: adal.Token{
+				AccessToken:  "management",
+				RefreshToken: "refresh",
+				Resource:     "https://management.core.windows.net/",
+			},
+			Resource:        "https://management.azure.com/",
+			ExpectRefreshed: false,
+		},
+		{
+			Description: "Resource Manager in China",
+			Environment: azure.ChinaCloud,
+			Token: adal.Token{
+				AccessToken:  "management",
+				RefreshToken: "refresh",
+				Resource:     "https://management.core.chinacloudapi.cn/",
+			},
+			Resource:        "https://management.chinacloudapi.cn/",
+			ExpectRefreshed: false,
+		},
+		{
+			Description: "Resource Manager of another Environment",
+			Environment: azure.PublicCloud,
+			Token: adal.Token{
+				AccessToken:  "management",
+				RefreshToken: "refresh",
+				Resource:     "https://management.core.windows.net/",
+			},
+			Resource:        "https://management.chinacloudapi.cn/",
+			ExpectRefreshed: true,
+		},
+		{
+			Description: "Other resource containing management",
+			Environment: azure.PublicCloud,
+			Token: adal.Token{
+				AccessToken:  "management",
+				RefreshToken: "refresh",
+				Resource:     "https://management.core.windows.net/",
+			},
+			Resource:        "https://management.example.com/",
+			ExpectRefreshed: true,
+		},
+		{
+			Description: "Graph",
+			Environment: azure.PublicCloud,
+			Token: adal.Token{
+				AccessToken:  "management",
+				RefreshToken: "refresh",
+				Resource:     "https://management.core.windows.net/",
+			},
+			Resource:        "https://graph.windows.net/",
+			ExpectRefreshed: true,
+		},
+		{
+			Description: "Key Vault",
+			Environment: azure.PublicCloud,
+			Token: adal.Token{
+				AccessToken:  "management",
+				RefreshToken: "refresh",
+				Resource:     "https://management.core.windows.net/",
+			},
+			Resource:        "https://vault.azure.net",
+			ExpectRefreshed: true,
+		},
+		{
+			Description: "CloudShell",
+			Environment: azure.PublicCloud,
+			Token: adal.Token{
+				AccessToken: "management",
+				Resource:    "https://management.core.windows.net/",
+			},
+			Resource:        "https://graph.windows.net/",
+			ExpectRefreshed: false,
+		},
+	}
+
+	for _, v := range cases {
+		config := Config{
+			AccessToken: &v.Token,
+		}
+
+		token := config.AccessTokenForResource(v.Environment, v.Resource)
+		if v.ExpectRefreshed {
+			if !token.IsExpired() || token.AccessToken != "" || token.Resource != v.Resource {
+				t.Fatalf("Expected the token for %q to be refreshed for %q but got %+v", v.Description, v.Resource, token)
+			}
+		} else if token != v.Token {
+			t.Fatalf("Expected the token for %q to be unchanged but got %+v", v.Description, token)
+		}
+
+		if token.RefreshToken != v.Token.RefreshToken {
+			t.Fatalf("Expected the Refresh Token for %q to be %q but got %q", v.Description, v.Token.RefreshToken, token.RefreshToken)
+		}
+	}
+}
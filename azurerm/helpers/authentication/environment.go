@@ -1,6 +1,30 @@
 package authentication
 
-import "strings"
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// metadataApiVersion is the API Version used to retrieve the metadata from an ARM Endpoint
+const metadataApiVersion = "2015-01-01"
+
+// metadataEndpoints is the response returned from the `/metadata/endpoints` API on an ARM Endpoint
+type metadataEndpoints struct {
+	GalleryEndpoint string `json:"galleryEndpoint"`
+	GraphEndpoint   string `json:"graphEndpoint"`
+	PortalEndpoint  string `json:"portalEndpoint"`
+	Authentication  struct {
+		LoginEndpoint string   `json:"loginEndpoint"`
+		Audiences     []string `json:"audiences"`
+	} `json:"authentication"`
+}
 
 func normalizeEnvironmentName(input string) string {
 	// Environment is stored as `Azure{Environment}Cloud`
@@ -14,3 +38,113 @@ func normalizeEnvironmentName(input string) string {
 	}
 	return output
 }
+
+// DetermineEnvironment returns the Azure Environment for this Configuration - which is either built
+// from the metadata published by the ARM Endpoint (when specified) or one of the built-in clouds.
+func (c *Config) DetermineEnvironment() (*azure.Environment, error) {
+	if c.ArmEndpoint != "" {
+		return EnvironmentFromArmEndpoint(c.ArmEndpoint)
+	}
+
+	return environmentFromName(c.Environment)
+}
+
+func environmentFromName(name string) (*azure.Environment, error) {
+	env, envErr := azure.EnvironmentFromName(name)
+	if envErr != nil {
+		// try again with wrapped value to support readable values like german instead of AZUREGERMANCLOUD
+		wrapped := fmt.Sprintf("AZURE%sCLOUD", name)
+		var innerErr error
+		if env, innerErr = azure.EnvironmentFromName(wrapped); innerErr != nil {
+			return nil, envErr
+		}
+	}
+
+	return &env, nil
+}
+
+// EnvironmentFromArmEndpoint builds an Azure Environment (e.g. for Azure Stack) from the metadata
+// published at `/metadata/endpoints` on the specified ARM Endpoint.
+func EnvironmentFromArmEndpoint(armEndpoint string) (*azure.Environment, error) {
+	endpoint, err := url.Parse(armEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the ARM Endpoint %q: %+v", armEndpoint, err)
+	}
+	if endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("The ARM Endpoint %q must be an absolute URL (e.g. `https://management.local.azurestack.external`)", armEndpoint)
+	}
+
+	metadata, err := retrieveMetadataEndpoints(*endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(metadata.Authentication.Audiences) == 0 {
+		return nil, fmt.Errorf("The metadata for the ARM Endpoint %q didn't contain any Authentication Audiences", armEndpoint)
+	}
+
+	domain, err := domainFromArmHostname(endpoint.Hostname())
+	if err != nil {
+		return nil, err
+	}
+
+	env := azure.Environment{
+		Name:                       "AzureStackCloud",
+		ManagementPortalURL:        metadata.PortalEndpoint,
+		ServiceManagementEndpoint:  metadata.Authentication.Audiences[0],
+		ResourceManagerEndpoint:    fmt.Sprintf("%s://%s/", endpoint.Scheme, endpoint.Host),
+		ActiveDirectoryEndpoint:    metadata.Authentication.LoginEndpoint,
+		GalleryEndpoint:            metadata.GalleryEndpoint,
+		KeyVaultEndpoint:           fmt.Sprintf("https://vault.%s/", domain),
+		GraphEndpoint:              metadata.GraphEndpoint,
+		StorageEndpointSuffix:      domain,
+		KeyVaultDNSSuffix:          fmt.Sprintf("vault.%s", domain),
+		ResourceManagerVMDNSSuffix: fmt.Sprintf("cloudapp.%s", domain),
+	}
+
+	return &env, nil
+}
+
+// domainFromArmHostname returns the domain the other services are hosted under, which is the same as ARM -
+// e.g. for the ARM Endpoint `management.local.azurestack.external` the domain is `local.azurestack.external`
+func domainFromArmHostname(hostname string) (string, error) {
+	separator := strings.Index(hostname, ".")
+	if separator == -1 || separator == len(hostname)-1 {
+		return "", fmt.Errorf("Unable to determine the domain from the ARM Endpoint hostname %q", hostname)
+	}
+
+	return hostname[separator+1:], nil
+}
+
+func retrieveMetadataEndpoints(endpoint url.URL) (*metadataEndpoints, error) {
+	endpoint.Path = "/metadata/endpoints"
+	endpoint.RawQuery = url.Values{
+		"api-version": []string{metadataApiVersion},
+	}.Encode()
+	uri := endpoint.String()
+
+	client := http.Client{
+		Timeout: 30 * time.Second,
+	}
+	resp, err := client.Get(uri)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the metadata from %q: %+v", uri, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Error retrieving the metadata from %q: expected a 200 but got %d", uri, resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the metadata from %q: %+v", uri, err)
+	}
+
+	var metadata metadataEndpoints
+	if err := json.Unmarshal(body, &metadata); err != nil {
+		return nil, fmt.Errorf("Error parsing the metadata from %q: %+v", uri, err)
+	}
+
+	return &metadata, nil
+}
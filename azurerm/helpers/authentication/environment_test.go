@@ -1,6 +1,9 @@
 package authentication
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -21,3 +24,147 @@ func TestAzureEnvironmentNames(t *testing.T) {
 		}
 	}
 }
+
+func TestAzureEnvironmentFromArmEndpoint(t *testing.T) {
+	var requestedPath, requestedApiVersion string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		requestedApiVersion = r.URL.Query().Get("api-version")
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+  "galleryEndpoint": "https://portal.local.azurestack.external:30015/",
+  "graphEndpoint": "https://graph.windows.net/",
+  "portalEndpoint": "https://portal.local.azurestack.external/",
+  "authentication": {
+    "loginEndpoint": "https://login.windows.net/",
+    "audiences": [
+      "https://management.example.onmicrosoft.com/71fb132f-3b5b-4ed4-a4ab-b23cbd8c9ba9"
+    ]
+  }
+}`)
+	}))
+	defer server.Close()
+
+	env, err := EnvironmentFromArmEndpoint(server.URL)
+	if err != nil {
+		t.Fatalf("Error building the Environment from the ARM Endpoint: %+v", err)
+	}
+
+	if requestedPath != "/metadata/endpoints" {
+		t.Fatalf("Expected the metadata to be requested from %q but got %q", "/metadata/endpoints", requestedPath)
+	}
+
+	if requestedApiVersion != metadataApiVersion {
+		t.Fatalf("Expected the metadata to be requested with API Version %q but got %q", metadataApiVersion, requestedApiVersion)
+	}
+
+	// the test server listens on 127.0.0.1, so the domain is everything after the first label
+	testData := map[string][2]string{
+		"ResourceManagerEndpoint":   {server.URL + "/", env.ResourceManagerEndpoint},
+		"ServiceManagementEndpoint": {"https://management.example.onmicrosoft.com/71fb132f-3b5b-4ed4-a4ab-b23cbd8c9ba9", env.ServiceManagementEndpoint},
+		"ActiveDirectoryEndpoint":   {"https://login.windows.net/", env.ActiveDirectoryEndpoint},
+		"GraphEndpoint":             {"https://graph.windows.net/", env.GraphEndpoint},
+		"GalleryEndpoint":           {"https://portal.local.azurestack.external:30015/", env.GalleryEndpoint},
+		"ManagementPortalURL":       {"https://portal.local.azurestack.external/", env.ManagementPortalURL},
+		"KeyVaultEndpoint":          {"https://vault.0.0.1/", env.KeyVaultEndpoint},
+		"KeyVaultDNSSuffix":         {"vault.0.0.1", env.KeyVaultDNSSuffix},
+		"StorageEndpointSuffix":     {"0.0.1", env.StorageEndpointSuffix},
+	}
+
+	for field, values := range testData {
+		if values[0] != values[1] {
+			t.Fatalf("Expected %q to be %q but got %q", field, values[0], values[1])
+		}
+	}
+}
+
+func TestAzureEnvironmentFromArmEndpointErrors(t *testing.T) {
+	cases := []struct {
+		Description string
+		StatusCode  int
+		Body        string
+	}{
+		{
+			Description: "Not Found",
+			StatusCode:  http.StatusNotFound,
+			Body:        `{}`,
+		},
+		{
+			Description: "Invalid JSON",
+			StatusCode:  http.StatusOK,
+			Body:        `not-json`,
+		},
+		{
+			Description: "No Audiences",
+			StatusCode:  http.StatusOK,
+			Body:        `{"authentication": {"loginEndpoint": "https://login.windows.net/", "audiences": []}}`,
+		},
+	}
+
+	for _, v := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(v.StatusCode)
+			fmt.Fprint(w, v.Body)
+		}))
+
+		_, err := EnvironmentFromArmEndpoint(server.URL)
+		server.Close()
+
+		if err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Description)
+		}
+	}
+
+	if _, err := EnvironmentFromArmEndpoint("management.local.azurestack.external"); err == nil {
+		t.Fatalf("Expected an error for a relative ARM Endpoint but didn't get one")
+	}
+}
+
+func TestAzureDomainFromArmHostname(t *testing.T) {
+	cases := []struct {
+		Description string
+		Hostname    string
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Description: "Azure Stack",
+			Hostname:    "management.local.azurestack.external",
+			Expected:    "local.azurestack.external",
+		},
+		{
+			Description: "Custom Region",
+			Hostname:    "management.westus.contoso.com",
+			Expected:    "westus.contoso.com",
+		},
+		{
+			Description: "No Domain",
+			Hostname:    "localhost",
+			ExpectError: true,
+		},
+		{
+			Description: "Trailing Separator",
+			Hostname:    "management.",
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range cases {
+		actual, err := domainFromArmHostname(v.Hostname)
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error for %q but didn't get one", v.Description)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.Description, err)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q for %q but got %q", v.Expected, v.Description, actual)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package authentication
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 )
@@ -49,3 +51,78 @@ func (c *Config) ValidateServicePrincipal() error {
 
 	return err.ErrorOrNil()
 }
+
+func (c *Config) ValidateServicePrincipalCertificate() error {
+	var err *multierror.Error
+
+	if c.SubscriptionID == "" {
+		err = multierror.Append(err, fmt.Errorf("Subscription ID must be configured for the AzureRM provider"))
+	}
+	if c.ClientID == "" {
+		err = multierror.Append(err, fmt.Errorf("Client ID must be configured for the AzureRM provider"))
+	}
+	if c.ClientCertPath == "" {
+		err = multierror.Append(err, fmt.Errorf("Client Certificate Path must be configured for the AzureRM provider"))
+	} else {
+		if !strings.HasSuffix(strings.ToLower(c.ClientCertPath), ".pfx") {
+			err = multierror.Append(err, fmt.Errorf("Client Certificate Path must be the path to a PFX (PKCS#12) file"))
+		}
+		if _, statErr := os.Stat(c.ClientCertPath); statErr != nil {
+			err = multierror.Append(err, fmt.Errorf("Error reading the Client Certificate at %q: %+v", c.ClientCertPath, statErr))
+		}
+	}
+	if c.TenantID == "" {
+		err = multierror.Append(err, fmt.Errorf("Tenant ID must be configured for the AzureRM provider"))
+	}
+	if c.Environment == "" {
+		err = multierror.Append(err, fmt.Errorf("Environment must be configured for the AzureRM provider"))
+	}
+
+	return err.ErrorOrNil()
+}
+
+func (c *Config) ValidateMsi() error {
+	var err *multierror.Error
+
+	if c.SubscriptionID == "" {
+		err = multierror.Append(err, fmt.Errorf("Subscription ID must be configured for the AzureRM provider"))
+	}
+	if c.TenantID == "" {
+		err = multierror.Append(err, fmt.Errorf("Tenant ID must be configured for the AzureRM provider"))
+	}
+	if c.Environment == "" {
+		err = multierror.Append(err, fmt.Errorf("Environment must be configured for the AzureRM provider"))
+	}
+	if c.MsiEndpoint == "" {
+		err = multierror.Append(err, fmt.Errorf("MSI endpoint must be configured for the AzureRM provider"))
+	}
+
+	return err.ErrorOrNil()
+}
+
+// maxAuxiliaryTenants is the maximum number of Auxiliary Tenants supported by Resource Manager
+const maxAuxiliaryTenants = 3
+
+func (c *Config) ValidateAuxiliaryTenants() error {
+	var err *multierror.Error
+
+	if len(c.AuxiliaryTenantIDs) == 0 {
+		return nil
+	}
+
+	if c.ClientSecret == "" && c.ClientCertPath == "" {
+		err = multierror.Append(err, fmt.Errorf("Auxiliary Tenants can only be used when authenticating using a Service Principal"))
+	}
+	if len(c.AuxiliaryTenantIDs) > maxAuxiliaryTenants {
+		err = multierror.Append(err, fmt.Errorf("A maximum of %d Auxiliary Tenants can be configured for the AzureRM provider", maxAuxiliaryTenants))
+	}
+	for _, tenantId := range c.AuxiliaryTenantIDs {
+		if tenantId == "" {
+			err = multierror.Append(err, fmt.Errorf("Auxiliary Tenant IDs cannot be empty"))
+		} else if strings.EqualFold(tenantId, c.TenantID) {
+			err = multierror.Append(err, fmt.Errorf("Auxiliary Tenant %q cannot be the same as the Tenant ID", tenantId))
+		}
+	}
+
+	return err.ErrorOrNil()
+}
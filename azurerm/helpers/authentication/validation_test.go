@@ -1,6 +1,9 @@
 package authentication
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/adal"
@@ -164,3 +167,270 @@ func TestAzureValidateServicePrincipal(t *testing.T) {
 		}
 	}
 }
+
+func TestAzureValidateMsi(t *testing.T) {
+	cases := []struct {
+		Description string
+		Config      Config
+		ExpectError bool
+	}{
+		{
+			Description: "Empty Configuration",
+			Config:      Config{},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Subscription ID",
+			Config: Config{
+				MsiEndpoint: "http://localhost:50342/oauth2/token",
+				TenantID:    "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment: "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Tenant ID",
+			Config: Config{
+				MsiEndpoint:    "http://localhost:50342/oauth2/token",
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Environment",
+			Config: Config{
+				MsiEndpoint:    "http://localhost:50342/oauth2/token",
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing MSI Endpoint",
+			Config: Config{
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Valid Configuration",
+			Config: Config{
+				MsiEndpoint:    "http://localhost:50342/oauth2/token",
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range cases {
+		err := v.Config.ValidateMsi()
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+		}
+
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+	}
+}
+
+func TestAzureValidateServicePrincipalCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-azurerm-certificate")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	certificatePath := filepath.Join(dir, "client.pfx")
+	if err := ioutil.WriteFile(certificatePath, []byte{}, 0600); err != nil {
+		t.Fatalf("Error writing the Client Certificate: %+v", err)
+	}
+
+	cases := []struct {
+		Description string
+		Config      Config
+		ExpectError bool
+	}{
+		{
+			Description: "Empty Configuration",
+			Config:      Config{},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Client ID",
+			Config: Config{
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientCertPath: certificatePath,
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Subscription ID",
+			Config: Config{
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				ClientCertPath: certificatePath,
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Client Certificate Path",
+			Config: Config{
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Client Certificate Path which doesn't exist",
+			Config: Config{
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientCertPath: filepath.Join(dir, "missing.pfx"),
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Client Certificate Path which isn't a PFX",
+			Config: Config{
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientCertPath: dir,
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Tenant ID",
+			Config: Config{
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientCertPath: certificatePath,
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Environment",
+			Config: Config{
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientCertPath: certificatePath,
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Valid Configuration",
+			Config: Config{
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientCertPath: certificatePath,
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range cases {
+		err := v.Config.ValidateServicePrincipalCertificate()
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+		}
+
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+	}
+}
+
+func TestAzureValidateAuxiliaryTenants(t *testing.T) {
+	cases := []struct {
+		Description string
+		Config      Config
+		ExpectError bool
+	}{
+		{
+			Description: "No Auxiliary Tenants",
+			Config:      Config{},
+			ExpectError: false,
+		},
+		{
+			Description: "Not using a Service Principal",
+			Config: Config{
+				TenantID:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				AuxiliaryTenantIDs: []string{"5f1d2b0a-a6b6-4c83-a2a5-a2e4e6b0b6d1"},
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Too many Auxiliary Tenants",
+			Config: Config{
+				ClientSecret: "Does Hammer Time have Daylight Savings Time?",
+				TenantID:     "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				AuxiliaryTenantIDs: []string{
+					"5f1d2b0a-a6b6-4c83-a2a5-a2e4e6b0b6d1",
+					"0b3b5c8e-6a0e-4bbf-9d6f-2b1c3f0f3c4a",
+					"2f7e5a9c-1d3b-4c6e-8f0a-9b8c7d6e5f4a",
+					"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Empty Auxiliary Tenant",
+			Config: Config{
+				ClientSecret:       "Does Hammer Time have Daylight Savings Time?",
+				TenantID:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				AuxiliaryTenantIDs: []string{""},
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Auxiliary Tenant is the Primary Tenant",
+			Config: Config{
+				ClientSecret:       "Does Hammer Time have Daylight Savings Time?",
+				TenantID:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				AuxiliaryTenantIDs: []string{"9834F8D0-24B3-41B7-8B8D-C611C461A129"},
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Valid Configuration",
+			Config: Config{
+				ClientSecret:       "Does Hammer Time have Daylight Savings Time?",
+				TenantID:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				AuxiliaryTenantIDs: []string{"5f1d2b0a-a6b6-4c83-a2a5-a2e4e6b0b6d1"},
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range cases {
+		err := v.Config.ValidateAuxiliaryTenants()
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+		}
+
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+	}
+}
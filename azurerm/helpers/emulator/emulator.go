@@ -0,0 +1,703 @@
+// Package emulator contains an in-memory emulation of Azure Resource Manager, which allows the Create, Read,
+// Update and Delete logic of resources to be tested without making requests to Azure.
+package emulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// SubscriptionID is the ID of the Subscription the emulator contains resources for
+	SubscriptionID = "00000000-0000-0000-0000-000000000000"
+
+	// TenantID is the ID of the Tenant which the emulator issues tokens for
+	TenantID = "11111111-1111-1111-1111-111111111111"
+
+	// ClientID and ClientSecret are the credentials accepted by the emulator
+	ClientID     = "22222222-2222-2222-2222-222222222222"
+	ClientSecret = "emulator"
+
+	// operationsPath is where the status of asynchronous operations is published
+	operationsPath = "/emulator/operations/"
+)
+
+// Server is an in-memory emulation of Azure Resource Manager, which supports creating (or updating), retrieving,
+// patching, listing and deleting resources - including the `Azure-AsyncOperation` and `Location` headers used
+// for long running operations.
+type Server struct {
+	// Async configures whether PUT and DELETE requests complete asynchronously (as most resources do in
+	// Azure) - which requires the SDK to poll the status of the operation
+	Async bool
+
+	server *httptest.Server
+
+	lock                 sync.Mutex
+	resources            map[string]map[string]interface{}
+	computed             map[string]map[string]interface{}
+	apiVersions          map[string]map[string]struct{}
+	operations           int
+	operationsInProgress bool
+	requests             []string
+}
+
+// defaultComputedProperties are the read-only properties Azure returns for each resource type, which
+// the Read functions depend on being set
+var defaultComputedProperties = map[string]map[string]interface{}{
+	"microsoft.network/dnszones": {
+		"maxNumberOfRecordSets": 5000,
+		"nameServers": []interface{}{
+			"ns1-01.azure-dns.com.",
+			"ns2-01.azure-dns.net.",
+			"ns3-01.azure-dns.org.",
+			"ns4-01.azure-dns.info.",
+		},
+		"numberOfRecordSets": 2,
+	},
+	"microsoft.network/loadbalancers": {
+		"backendAddressPools":      []interface{}{},
+		"frontendIPConfigurations": []interface{}{},
+		"inboundNatPools":          []interface{}{},
+		"inboundNatRules":          []interface{}{},
+		"loadBalancingRules":       []interface{}{},
+		"probes":                   []interface{}{},
+	},
+}
+
+// availableLocations are the names of the locations available to the Subscription
+var availableLocations = []string{
+	"eastus",
+	"northeurope",
+	"southeastasia",
+	"uksouth",
+	"westeurope",
+	"westus",
+}
+
+// synchronousResourceTypes are the resource types (and the types nested within them) which Azure deletes
+// synchronously, regardless of whether the emulator is asynchronous - since the SDK doesn't poll for these
+var synchronousResourceTypes = []string{
+	// DNS Record Sets, e.g. `Microsoft.Network/dnszones/A`
+	"microsoft.network/dnszones/",
+
+	// SQL Databases, e.g. `Microsoft.Sql/servers/databases`
+	"microsoft.sql/servers/databases",
+}
+
+// NewServer starts a new emulator, which must be closed once the test has finished.
+func NewServer() *Server {
+	s := &Server{
+		Async:       true,
+		resources:   make(map[string]map[string]interface{}),
+		computed:    make(map[string]map[string]interface{}),
+		apiVersions: make(map[string]map[string]struct{}),
+	}
+	for resourceType, properties := range defaultComputedProperties {
+		s.computed[resourceType] = properties
+	}
+	s.server = httptest.NewServer(s)
+	return s
+}
+
+// Close shuts down the emulator.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// URL returns the URL of the emulator, which can be used as the `arm_endpoint` in the Provider block - since
+// the emulator publishes metadata pointing Active Directory and Graph to itself.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// SetComputedProperties configures the read-only properties which are returned for resources of the specified
+// type (e.g. `Microsoft.Network/dnszones`) when they're created, unless they're specified in the request.
+func (s *Server) SetComputedProperties(resourceType string, properties map[string]interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.computed[strings.ToLower(resourceType)] = properties
+}
+
+// SetOperationsInProgress configures whether asynchronous operations remain in progress (rather than completing
+// the first time their status is polled) - for testing operations which are interrupted, e.g. by a timeout.
+func (s *Server) SetOperationsInProgress(inProgress bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.operationsInProgress = inProgress
+}
+
+// Resource returns a copy of the resource with the specified ID, if it exists.
+func (s *Server) Resource(id string) (map[string]interface{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	resource, ok := s.resources[strings.ToLower(id)]
+	if !ok {
+		return nil, false
+	}
+
+	return copyResource(resource), true
+}
+
+// Create creates (or updates) the resource with the specified ID outside of Terraform, for testing resources
+// which aren't managed by Terraform.
+func (s *Server) Create(id string, resource map[string]interface{}) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	path, err := parseResourcePath(id)
+	if err != nil {
+		return err
+	}
+
+	if path.resourceGroup != "" && !path.isResourceGroup() {
+		if _, ok := s.resources[path.resourceGroupKey()]; !ok {
+			return fmt.Errorf("The Resource Group %q doesn't exist in the emulator", path.resourceGroup)
+		}
+	}
+
+	resource = copyResource(resource)
+	s.populateComputedProperties(path, resource, s.resources[path.key()])
+	s.store(path, resource)
+	return nil
+}
+
+// Delete removes the resource with the specified ID (and any resources within it) outside of Terraform,
+// for testing that resources which are deleted are removed from the state.
+func (s *Server) Delete(id string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := strings.ToLower(id)
+	if _, ok := s.resources[key]; !ok {
+		return fmt.Errorf("The resource %q doesn't exist in the emulator", id)
+	}
+
+	s.deleteResource(key)
+	return nil
+}
+
+// ResourceIDs returns the IDs of each of the resources within the emulator.
+func (s *Server) ResourceIDs() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	ids := make([]string, 0)
+	for _, resource := range s.resources {
+		ids = append(ids, resource["id"].(string))
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Requests returns the method and path of each request made to the emulator, e.g. `PUT /subscriptions/...`
+func (s *Server) Requests() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]string{}, s.requests...)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.requests = append(s.requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+	w.Header().Set("Content-Type", "application/json")
+
+	// there's no need to wait between polling requests
+	w.Header().Set("Retry-After", "0")
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	switch {
+	case path == "/metadata/endpoints":
+		s.metadata(w)
+	case strings.HasSuffix(path, "/oauth2/token"):
+		s.token(w, r)
+	case strings.HasPrefix(path, operationsPath):
+		status := "Succeeded"
+		if s.operationsInProgress {
+			status = "InProgress"
+		}
+		writeJson(w, http.StatusOK, map[string]interface{}{
+			"status": status,
+		})
+	case strings.EqualFold(path, "/subscriptions/"+SubscriptionID+"/locations"):
+		s.locations(w)
+	case !strings.HasPrefix(strings.ToLower(path), strings.ToLower("/subscriptions/"+SubscriptionID)):
+		writeError(w, http.StatusNotFound, "SubscriptionNotFound", fmt.Sprintf("The subscription for %q could not be found.", path))
+	default:
+		s.resourceManager(w, r, path)
+	}
+}
+
+func (s *Server) metadata(w http.ResponseWriter) {
+	writeJson(w, http.StatusOK, map[string]interface{}{
+		"galleryEndpoint": s.server.URL + "/",
+		"graphEndpoint":   s.server.URL + "/",
+		"portalEndpoint":  s.server.URL + "/",
+		"authentication": map[string]interface{}{
+			"loginEndpoint": s.server.URL + "/",
+			"audiences":     []string{s.server.URL + "/"},
+		},
+	})
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.Form.Get("client_id") != ClientID || r.Form.Get("client_secret") != ClientSecret {
+		writeError(w, http.StatusUnauthorized, "invalid_client", "The Client ID or Secret is invalid.")
+		return
+	}
+
+	writeJson(w, http.StatusOK, map[string]interface{}{
+		"access_token": "emulator-token",
+		"expires_in":   "3600",
+		"expires_on":   "4102444800",
+		"not_before":   "1514764800",
+		"resource":     r.Form.Get("resource"),
+		"token_type":   "Bearer",
+	})
+}
+
+func (s *Server) locations(w http.ResponseWriter) {
+	values := make([]interface{}, 0)
+	for _, name := range availableLocations {
+		values = append(values, map[string]interface{}{
+			"id":             fmt.Sprintf("/subscriptions/%s/locations/%s", SubscriptionID, name),
+			"subscriptionId": SubscriptionID,
+			"name":           name,
+		})
+	}
+
+	writeJson(w, http.StatusOK, map[string]interface{}{
+		"value": values,
+	})
+}
+
+func (s *Server) resourceManager(w http.ResponseWriter, r *http.Request, path string) {
+	id, err := parseResourcePath(path)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidResourceId", err.Error())
+		return
+	}
+
+	// Resource Providers are always registered
+	if id.isProviderNamespace() {
+		if id.providerNamespace == "" {
+			writeJson(w, http.StatusOK, map[string]interface{}{
+				"value": []interface{}{},
+			})
+			return
+		}
+
+		writeJson(w, http.StatusOK, map[string]interface{}{
+			"id":                path,
+			"namespace":         id.providerNamespace,
+			"registrationState": "Registered",
+			"resourceTypes":     s.resourceTypes(id.providerNamespace),
+		})
+		return
+	}
+
+	if r.Method == http.MethodPost && strings.EqualFold(id.name(), "checkNameAvailability") {
+		s.checkNameAvailability(w, r)
+		return
+	}
+
+	if id.isCollection() {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s isn't supported for %q", r.Method, path))
+			return
+		}
+
+		s.list(w, id)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.get(w, id)
+	case http.MethodHead:
+		s.head(w, id)
+	case http.MethodPut:
+		s.put(w, r, id)
+	case http.MethodPatch:
+		s.patch(w, r, id)
+	case http.MethodDelete:
+		s.delete(w, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s isn't supported for %q", r.Method, path))
+	}
+}
+
+// checkNameAvailability reports whether a resource of the requested type already exists with the requested name,
+// in any Resource Group. Resource Providers differ in whether this is returned as `nameAvailable` or `available`,
+// as such both are returned.
+func (s *Server) checkNameAvailability(w http.ResponseWriter, r *http.Request) {
+	request, err := readJson(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	name, _ := request["name"].(string)
+	resourceType, _ := request["type"].(string)
+
+	available := true
+	for _, resource := range s.resources {
+		if strings.EqualFold(resource["name"].(string), name) && strings.EqualFold(resource["type"].(string), resourceType) {
+			available = false
+			break
+		}
+	}
+
+	response := map[string]interface{}{
+		"nameAvailable": available,
+		"available":     available,
+	}
+	if !available {
+		response["reason"] = "AlreadyExists"
+		response["message"] = fmt.Sprintf("The name '%s' is already in use.", name)
+	}
+
+	writeJson(w, http.StatusOK, response)
+}
+
+func (s *Server) get(w http.ResponseWriter, id resourcePath) {
+	resource, ok := s.resources[id.key()]
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+
+	writeJson(w, http.StatusOK, resource)
+}
+
+func (s *Server) head(w http.ResponseWriter, id resourcePath) {
+	if _, ok := s.resources[id.key()]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) list(w http.ResponseWriter, id resourcePath) {
+	values := make([]interface{}, 0)
+	keys := make([]string, 0)
+	for key, resource := range s.resources {
+		if id.isParentOf(resource["id"].(string)) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+	for _, key := range keys {
+		values = append(values, s.resources[key])
+	}
+
+	writeJson(w, http.StatusOK, map[string]interface{}{
+		"value": values,
+	})
+}
+
+func (s *Server) put(w http.ResponseWriter, r *http.Request, id resourcePath) {
+	if !s.parentExists(w, id) {
+		return
+	}
+
+	resource, err := readJson(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	existing, exists := s.resources[id.key()]
+	s.populateComputedProperties(id, resource, existing)
+	s.store(id, resource)
+	s.recordAPIVersion(id, r.URL.Query().Get("api-version"))
+
+	status := http.StatusOK
+	if !exists {
+		status = http.StatusCreated
+	}
+
+	if s.Async {
+		s.operations++
+		w.Header().Set("Azure-AsyncOperation", fmt.Sprintf("%s%s%d", s.server.URL, operationsPath, s.operations))
+
+		// the resource is returned as it would be whilst the operation is in progress
+		inProgress := copyResource(resource)
+		inProgress["properties"].(map[string]interface{})["provisioningState"] = "Updating"
+		writeJson(w, status, inProgress)
+		return
+	}
+
+	writeJson(w, status, resource)
+}
+
+func (s *Server) patch(w http.ResponseWriter, r *http.Request, id resourcePath) {
+	existing, ok := s.resources[id.key()]
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+
+	update, err := readJson(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	resource := copyResource(existing)
+	mergeJson(resource, update)
+	s.store(id, resource)
+
+	writeJson(w, http.StatusOK, resource)
+}
+
+func (s *Server) delete(w http.ResponseWriter, id resourcePath) {
+	if _, ok := s.resources[id.key()]; !ok {
+		// Resource Manager returns a 404 when deleting a Resource Group which doesn't exist,
+		// but a 204 for other types of resources
+		if id.isResourceGroup() {
+			writeNotFound(w, id)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	s.deleteResource(id.key())
+
+	if s.Async && !isSynchronous(id) {
+		s.operations++
+		w.Header().Set("Location", fmt.Sprintf("%s%s%d", s.server.URL, operationsPath, s.operations))
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// deleteResource removes the resource, along with any resources within it (e.g. the resources within
+// a Resource Group)
+func (s *Server) deleteResource(key string) {
+	for k := range s.resources {
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(s.resources, k)
+		}
+	}
+}
+
+// parentExists checks that the Resource Group (or parent resource) the resource is within exists, returning
+// a 404 if it doesn't - as Azure does.
+func (s *Server) parentExists(w http.ResponseWriter, id resourcePath) bool {
+	if id.resourceGroup != "" && !id.isResourceGroup() {
+		if _, ok := s.resources[id.resourceGroupKey()]; !ok {
+			writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", id.resourceGroup))
+			return false
+		}
+	}
+
+	if parent := id.parent(); parent != nil {
+		if _, ok := s.resources[parent.key()]; !ok {
+			writeError(w, http.StatusNotFound, "ParentResourceNotFound", fmt.Sprintf("Can not perform requested operation on nested resource. Parent resource '%s' not found.", parent.name()))
+			return false
+		}
+	}
+
+	return true
+}
+
+// populateComputedProperties sets any read-only properties which weren't specified in the request, retaining
+// the values from the existing resource when it's updated.
+func (s *Server) populateComputedProperties(id resourcePath, resource map[string]interface{}, existing map[string]interface{}) {
+	computed, ok := s.computed[strings.ToLower(id.resourceType())]
+	if !ok {
+		return
+	}
+
+	properties, ok := resource["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+		resource["properties"] = properties
+	}
+
+	existingProperties, _ := existing["properties"].(map[string]interface{})
+	for key, value := range computed {
+		if _, ok := properties[key]; ok {
+			continue
+		}
+
+		if v, ok := existingProperties[key]; ok {
+			properties[key] = v
+			continue
+		}
+
+		properties[key] = value
+	}
+}
+
+// store saves the resource, populating the read-only properties which Azure returns
+func (s *Server) store(id resourcePath, resource map[string]interface{}) {
+	resource["id"] = id.path
+	resource["name"] = id.name()
+	resource["type"] = id.resourceType()
+
+	properties, ok := resource["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+		resource["properties"] = properties
+	}
+	properties["provisioningState"] = "Succeeded"
+
+	// Azure assigns an ID to each of the sub-resources defined inline (e.g. the Rules within a Load Balancer)
+	for key, value := range properties {
+		items, ok := value.([]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, item := range items {
+			subResource, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if name, ok := subResource["name"].(string); ok && subResource["id"] == nil {
+				subResource["id"] = fmt.Sprintf("%s/%s/%s", id.path, key, name)
+			}
+		}
+	}
+
+	s.resources[id.key()] = resource
+}
+
+// recordAPIVersion records the API version a resource was created (or updated) with, so that it's published
+// as an API version of the resource type by the Resource Provider
+func (s *Server) recordAPIVersion(id resourcePath, apiVersion string) {
+	if apiVersion == "" {
+		return
+	}
+
+	resourceType := strings.ToLower(id.resourceType())
+	if _, ok := s.apiVersions[resourceType]; !ok {
+		s.apiVersions[resourceType] = make(map[string]struct{})
+	}
+	s.apiVersions[resourceType][apiVersion] = struct{}{}
+}
+
+// resourceTypes returns the resource types within the namespace of a Resource Provider, along with the API
+// versions they've been used with
+func (s *Server) resourceTypes(namespace string) []interface{} {
+	prefix := strings.ToLower(namespace) + "/"
+
+	types := make([]string, 0)
+	for resourceType := range s.apiVersions {
+		if strings.HasPrefix(resourceType, prefix) {
+			types = append(types, resourceType)
+		}
+	}
+	sort.Strings(types)
+
+	results := make([]interface{}, 0)
+	for _, resourceType := range types {
+		versions := make([]string, 0)
+		for version := range s.apiVersions[resourceType] {
+			versions = append(versions, version)
+		}
+		sort.Strings(versions)
+
+		results = append(results, map[string]interface{}{
+			"resourceType": strings.TrimPrefix(resourceType, prefix),
+			"apiVersions":  versions,
+		})
+	}
+
+	return results
+}
+
+func isSynchronous(id resourcePath) bool {
+	resourceType := strings.ToLower(id.resourceType())
+	for _, prefix := range synchronousResourceTypes {
+		if strings.HasPrefix(resourceType, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeNotFound(w http.ResponseWriter, id resourcePath) {
+	if id.isResourceGroup() {
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", id.resourceGroup))
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource '%s' under resource group '%s' was not found.", id.resourceType()+"/"+id.name(), id.resourceGroup))
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code string, message string) {
+	writeJson(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func writeJson(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
+
+func readJson(r *http.Request) (map[string]interface{}, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := make(map[string]interface{})
+	if len(body) == 0 {
+		return resource, nil
+	}
+
+	if err := json.Unmarshal(body, &resource); err != nil {
+		return nil, fmt.Errorf("The request content was invalid and could not be deserialized: %+v", err)
+	}
+
+	return resource, nil
+}
+
+// copyResource returns a deep copy of the resource
+func copyResource(resource map[string]interface{}) map[string]interface{} {
+	data, _ := json.Marshal(resource)
+	output := make(map[string]interface{})
+	json.Unmarshal(data, &output)
+	return output
+}
+
+// mergeJson merges the update into the resource, as a PATCH does - where nested objects are merged and
+// any other values are replaced.
+func mergeJson(resource map[string]interface{}, update map[string]interface{}) {
+	for key, value := range update {
+		existing, existingIsObject := resource[key].(map[string]interface{})
+		updated, updatedIsObject := value.(map[string]interface{})
+		if existingIsObject && updatedIsObject && key != "tags" {
+			mergeJson(existing, updated)
+			continue
+		}
+
+		resource[key] = value
+	}
+}
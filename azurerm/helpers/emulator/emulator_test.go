@@ -0,0 +1,268 @@
+package emulator
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestServer_LongRunningOperations(t *testing.T) {
+	for _, async := range []bool{true, false} {
+		server := NewServer()
+		server.Async = async
+		testServerLongRunningOperations(t, server)
+		server.Close()
+	}
+}
+
+func testServerLongRunningOperations(t *testing.T, server *Server) {
+	ctx := context.TODO()
+
+	groupsClient := resources.NewGroupsClientWithBaseURI(server.URL(), SubscriptionID)
+	groupsClient.Authorizer = autorest.NullAuthorizer{}
+	vnetClient := network.NewVirtualNetworksClientWithBaseURI(server.URL(), SubscriptionID)
+	vnetClient.Authorizer = autorest.NullAuthorizer{}
+	subnetsClient := network.NewSubnetsClientWithBaseURI(server.URL(), SubscriptionID)
+	subnetsClient.Authorizer = autorest.NullAuthorizer{}
+
+	vnet := network.VirtualNetwork{
+		Location: to("westeurope"),
+		VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
+			AddressSpace: &network.AddressSpace{
+				AddressPrefixes: &[]string{"10.0.0.0/16"},
+			},
+		},
+	}
+
+	// the Resource Group has to exist first
+	future, err := vnetClient.CreateOrUpdate(ctx, "example", "example", vnet)
+	if err == nil {
+		t.Fatalf("Expected an error creating a Virtual Network in a Resource Group which doesn't exist")
+	}
+	if future.Response() == nil || future.Response().StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected a 404 creating a Virtual Network in a Resource Group which doesn't exist but got %+v", future.Response())
+	}
+
+	if _, err := groupsClient.CreateOrUpdate(ctx, "example", resources.Group{Location: to("westeurope")}); err != nil {
+		t.Fatalf("Error creating the Resource Group: %+v", err)
+	}
+
+	future, err = vnetClient.CreateOrUpdate(ctx, "example", "example", vnet)
+	if err != nil {
+		t.Fatalf("Error creating the Virtual Network: %+v", err)
+	}
+	if err := future.WaitForCompletion(ctx, vnetClient.Client); err != nil {
+		t.Fatalf("Error waiting for the Virtual Network to be created: %+v", err)
+	}
+
+	subnetFuture, err := subnetsClient.CreateOrUpdate(ctx, "example", "example", "internal", network.Subnet{
+		SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+			AddressPrefix: to("10.0.2.0/24"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error creating the Subnet: %+v", err)
+	}
+	if err := subnetFuture.WaitForCompletion(ctx, subnetsClient.Client); err != nil {
+		t.Fatalf("Error waiting for the Subnet to be created: %+v", err)
+	}
+
+	subnet, err := subnetsClient.Get(ctx, "example", "example", "internal", "")
+	if err != nil {
+		t.Fatalf("Error retrieving the Subnet: %+v", err)
+	}
+	expectedId := "/subscriptions/" + SubscriptionID + "/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/subnets/internal"
+	if subnet.ID == nil || *subnet.ID != expectedId {
+		t.Fatalf("Expected the Subnet ID to be %q but got %+v", expectedId, subnet.ID)
+	}
+	if subnet.ProvisioningState == nil || *subnet.ProvisioningState != "Succeeded" {
+		t.Fatalf("Expected the Subnet to be provisioned but got %+v", subnet.ProvisioningState)
+	}
+
+	subnets, err := subnetsClient.List(ctx, "example", "example")
+	if err != nil {
+		t.Fatalf("Error listing the Subnets: %+v", err)
+	}
+	if len(subnets.Values()) != 1 {
+		t.Fatalf("Expected 1 Subnet but got %d", len(subnets.Values()))
+	}
+
+	// deleting the Resource Group deletes the resources within it
+	deleteFuture, err := groupsClient.Delete(ctx, "example")
+	if err != nil {
+		t.Fatalf("Error deleting the Resource Group: %+v", err)
+	}
+	if err := deleteFuture.WaitForCompletion(ctx, groupsClient.Client); err != nil {
+		t.Fatalf("Error waiting for the Resource Group to be deleted: %+v", err)
+	}
+
+	resp, err := vnetClient.Get(ctx, "example", "example", "")
+	if err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected a 404 retrieving the deleted Virtual Network but got %d: %+v", resp.StatusCode, err)
+	}
+
+	if ids := server.ResourceIDs(); len(ids) != 0 {
+		t.Fatalf("Expected no resources to remain but got %+v", ids)
+	}
+}
+
+func TestServer_Patch(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.TODO()
+	client := resources.NewGroupsClientWithBaseURI(server.URL(), SubscriptionID)
+	client.Authorizer = autorest.NullAuthorizer{}
+
+	if _, err := client.Update(ctx, "example", resources.GroupPatchable{}); err == nil {
+		t.Fatalf("Expected an error updating a Resource Group which doesn't exist")
+	}
+
+	group := resources.Group{
+		Location: to("westeurope"),
+		Tags: &map[string]*string{
+			"environment": to("production"),
+			"owner":       to("example"),
+		},
+	}
+	if _, err := client.CreateOrUpdate(ctx, "example", group); err != nil {
+		t.Fatalf("Error creating the Resource Group: %+v", err)
+	}
+
+	updated, err := client.Update(ctx, "example", resources.GroupPatchable{
+		Tags: &map[string]*string{
+			"environment": to("staging"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error updating the Resource Group: %+v", err)
+	}
+
+	if updated.Location == nil || *updated.Location != "westeurope" {
+		t.Fatalf("Expected the Location to be retained but got %+v", updated.Location)
+	}
+
+	// tags are replaced rather than merged
+	if updated.Tags == nil || len(*updated.Tags) != 1 || *(*updated.Tags)["environment"] != "staging" {
+		t.Fatalf("Expected the Tags to be replaced but got %+v", updated.Tags)
+	}
+}
+
+func TestResourcePath(t *testing.T) {
+	testCases := []struct {
+		Path          string
+		Collection    bool
+		Type          string
+		ParentPath    string
+		ResourceGroup string
+	}{
+		{
+			Path:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups",
+			Collection: true,
+			Type:       "Microsoft.Resources/resourcegroups",
+		},
+		{
+			Path:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			Type:          "Microsoft.Resources/resourceGroups",
+			ResourceGroup: "example",
+		},
+		{
+			Path:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks",
+			Collection:    true,
+			Type:          "Microsoft.Network/virtualNetworks",
+			ResourceGroup: "example",
+		},
+		{
+			Path:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/subnets/internal",
+			Type:          "Microsoft.Network/virtualNetworks/subnets",
+			ParentPath:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example",
+			ResourceGroup: "example",
+		},
+		{
+			Path:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/dnszones/example.com/A/www",
+			Type:          "Microsoft.Network/dnszones/A",
+			ParentPath:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/dnszones/example.com",
+			ResourceGroup: "example",
+		},
+	}
+
+	for _, v := range testCases {
+		id, err := parseResourcePath(v.Path)
+		if err != nil {
+			t.Fatalf("Error parsing %q: %+v", v.Path, err)
+		}
+
+		if id.isCollection() != v.Collection {
+			t.Fatalf("Expected %q to be a collection: %t", v.Path, v.Collection)
+		}
+
+		if id.resourceGroup != v.ResourceGroup {
+			t.Fatalf("Expected the Resource Group for %q to be %q but got %q", v.Path, v.ResourceGroup, id.resourceGroup)
+		}
+
+		if actual := id.resourceType(); actual != v.Type {
+			t.Fatalf("Expected the type of %q to be %q but got %q", v.Path, v.Type, actual)
+		}
+
+		if v.Collection {
+			continue
+		}
+
+		parentPath := ""
+		if parent := id.parent(); parent != nil {
+			parentPath = parent.path
+		}
+		if parentPath != v.ParentPath {
+			t.Fatalf("Expected the parent of %q to be %q but got %q", v.Path, v.ParentPath, parentPath)
+		}
+	}
+}
+
+func TestResourcePath_resourcesCollection(t *testing.T) {
+	network := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example"
+	testCases := []struct {
+		Path     string
+		Children map[string]bool
+	}{
+		{
+			Path: "/subscriptions/00000000-0000-0000-0000-000000000000/resources",
+			Children: map[string]bool{
+				network:                       true,
+				network + "/subnets/internal": false,
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example": false,
+			},
+		},
+		{
+			Path: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/EXAMPLE/resources",
+			Children: map[string]bool{
+				network: true,
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/other/providers/Microsoft.Network/virtualNetworks/example": false,
+			},
+		},
+	}
+
+	for _, v := range testCases {
+		id, err := parseResourcePath(v.Path)
+		if err != nil {
+			t.Fatalf("Error parsing %q: %+v", v.Path, err)
+		}
+
+		if !id.isResourcesCollection() {
+			t.Fatalf("Expected %q to be the collection of all resources", v.Path)
+		}
+
+		for child, expected := range v.Children {
+			if actual := id.isParentOf(child); actual != expected {
+				t.Fatalf("Expected %q to be within %q: %t", child, v.Path, expected)
+			}
+		}
+	}
+}
+
+func to(input string) *string {
+	return &input
+}
@@ -0,0 +1,166 @@
+package emulator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// resourcePath is the path of a resource (or a collection of resources) within the Subscription, e.g.
+// `/subscriptions/{id}/resourceGroups/{name}/providers/Microsoft.Network/virtualNetworks/{name}`
+type resourcePath struct {
+	path     string
+	segments []string
+
+	// typeSegments are the segments after the Subscription ID, excluding the `providers/{namespace}`
+	// segments - as such these alternate between the type and the name of each resource
+	typeSegments []string
+
+	resourceGroup     string
+	providerNamespace string
+}
+
+// canonicalResourceTypes are the resource types which Azure returns in the ID in a different casing to the
+// request, which the Read functions depend on when parsing the ID
+var canonicalResourceTypes = map[string]string{
+	"dnszones": "dnszones",
+}
+
+func parseResourcePath(path string) (resourcePath, error) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		return resourcePath{}, fmt.Errorf("The path %q isn't within a Subscription", path)
+	}
+
+	id := resourcePath{
+		path:         "/" + strings.Join(segments, "/"),
+		segments:     segments,
+		typeSegments: make([]string, 0),
+	}
+
+	for i := 2; i < len(segments); i++ {
+		if strings.EqualFold(segments[i], "providers") {
+			if i+1 < len(segments) {
+				id.providerNamespace = segments[i+1]
+			}
+			i++
+			continue
+		}
+
+		// Azure returns some resource types in a different casing to the one in the request
+		if len(id.typeSegments)%2 == 0 {
+			if canonical, ok := canonicalResourceTypes[strings.ToLower(segments[i])]; ok {
+				segments[i] = canonical
+			}
+		}
+
+		id.typeSegments = append(id.typeSegments, segments[i])
+	}
+	id.path = "/" + strings.Join(segments, "/")
+
+	if len(id.typeSegments) >= 2 && strings.EqualFold(id.typeSegments[0], "resourceGroups") {
+		id.resourceGroup = id.typeSegments[1]
+	}
+
+	return id, nil
+}
+
+// isProviderNamespace returns whether this is the path of a Resource Provider, or the list of Resource Providers
+func (id resourcePath) isProviderNamespace() bool {
+	return len(id.segments) <= 4 && len(id.segments) > 2 && strings.EqualFold(id.segments[2], "providers")
+}
+
+// isCollection returns whether this is the path of a list of resources, rather than an individual resource
+func (id resourcePath) isCollection() bool {
+	return len(id.typeSegments)%2 == 1
+}
+
+// isResourcesCollection returns whether this is the path of the list of all resources within the Subscription
+// or a Resource Group
+func (id resourcePath) isResourcesCollection() bool {
+	if id.providerNamespace != "" || !strings.EqualFold(id.typeSegments[len(id.typeSegments)-1], "resources") {
+		return false
+	}
+
+	return len(id.typeSegments) == 1 || (len(id.typeSegments) == 3 && id.resourceGroup != "")
+}
+
+func (id resourcePath) isResourceGroup() bool {
+	return len(id.typeSegments) == 2 && id.resourceGroup != ""
+}
+
+// key is the case-insensitive identifier the resource is stored with
+func (id resourcePath) key() string {
+	return strings.ToLower(id.path)
+}
+
+func (id resourcePath) resourceGroupKey() string {
+	return strings.ToLower(fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", id.segments[1], id.resourceGroup))
+}
+
+func (id resourcePath) name() string {
+	return id.segments[len(id.segments)-1]
+}
+
+// resourceType returns the fully qualified type of the resource, e.g. `Microsoft.Network/virtualNetworks/subnets`
+func (id resourcePath) resourceType() string {
+	if id.isResourceGroup() {
+		return "Microsoft.Resources/resourceGroups"
+	}
+
+	namespace := id.providerNamespace
+	if namespace == "" {
+		namespace = "Microsoft.Resources"
+	}
+
+	types := []string{namespace}
+	start := 0
+	if id.resourceGroup != "" {
+		start = 2
+	}
+	for i := start; i < len(id.typeSegments); i += 2 {
+		types = append(types, id.typeSegments[i])
+	}
+
+	return strings.Join(types, "/")
+}
+
+// parent returns the path of the resource this resource is nested within (e.g. the Virtual Network a Subnet
+// is within) - or nil if it's a top-level resource.
+func (id resourcePath) parent() *resourcePath {
+	segments := id.segments[:len(id.segments)-2]
+	if len(segments) >= 2 && strings.EqualFold(segments[len(segments)-2], "providers") {
+		segments = segments[:len(segments)-2]
+	}
+
+	parent, err := parseResourcePath(strings.Join(segments, "/"))
+	if err != nil || parent.isCollection() || parent.isResourceGroup() || len(parent.typeSegments) == 0 {
+		return nil
+	}
+
+	return &parent
+}
+
+// isParentOf returns whether the resource with the specified ID is within this collection
+func (id resourcePath) isParentOf(resourceId string) bool {
+	child, err := parseResourcePath(resourceId)
+	if err != nil || child.isCollection() {
+		return false
+	}
+
+	// e.g. listing all of the resources within the Subscription (or a Resource Group), which excludes Resource
+	// Groups and nested resources
+	if id.isResourcesCollection() {
+		if child.isResourceGroup() || child.resourceGroup == "" || child.parent() != nil {
+			return false
+		}
+
+		return id.resourceGroup == "" || strings.EqualFold(child.resourceGroup, id.resourceGroup)
+	}
+
+	// e.g. listing the Virtual Networks within the Subscription, rather than a Resource Group
+	if id.resourceGroup == "" && !strings.EqualFold(id.typeSegments[0], "resourceGroups") {
+		return strings.EqualFold(child.resourceType(), id.resourceType())
+	}
+
+	return strings.EqualFold(strings.Join(child.segments[:len(child.segments)-1], "/"), strings.Join(id.segments, "/"))
+}
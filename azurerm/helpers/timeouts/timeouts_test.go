@@ -0,0 +1,95 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestRemaining(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	remaining, err := Remaining(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error for a context with a deadline but got: %+v", err)
+	}
+	if remaining <= 4*time.Minute || remaining > 5*time.Minute {
+		t.Fatalf("Expected around 5 minutes to remain but got %s", remaining)
+	}
+}
+
+func TestRemaining_NoDeadline(t *testing.T) {
+	if _, err := Remaining(context.Background()); err == nil {
+		t.Fatalf("Expected an error for a context without a deadline")
+	}
+}
+
+func TestForCreateUpdate(t *testing.T) {
+	var remaining time.Duration
+	createUpdate := func(d *schema.ResourceData, meta interface{}) error {
+		ctx, cancel := ForCreateUpdate(context.Background(), d)
+		defer cancel()
+
+		var err error
+		remaining, err = Remaining(ctx)
+		d.SetId("example")
+		return err
+	}
+
+	create := 10 * time.Minute
+	update := 5 * time.Minute
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		Create: createUpdate,
+		Read:   func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Update: createUpdate,
+		Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Timeouts: &schema.ResourceTimeout{
+			Create: &create,
+			Update: &update,
+		},
+	}
+
+	// creating the resource uses the timeout for a Create
+	state := testApplyResource(t, resource, nil, "first")
+	if remaining <= create-time.Minute || remaining > create {
+		t.Fatalf("Expected the Create timeout (%s) to be used but got %s", create, remaining)
+	}
+
+	// whereas updating it uses the timeout for an Update
+	testApplyResource(t, resource, state, "second")
+	if remaining <= update-time.Minute || remaining > update {
+		t.Fatalf("Expected the Update timeout (%s) to be used but got %s", update, remaining)
+	}
+}
+
+func testApplyResource(t *testing.T, resource *schema.Resource, state *terraform.InstanceState, name string) *terraform.InstanceState {
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"name": name,
+	})
+	if err != nil {
+		t.Fatalf("Error building the config: %+v", err)
+	}
+
+	diff, err := resource.Diff(state, terraform.NewResourceConfig(raw))
+	if err != nil {
+		t.Fatalf("Error diffing the resource: %+v", err)
+	}
+
+	state, err = resource.Apply(state, diff, nil)
+	if err != nil {
+		t.Fatalf("Error applying the resource: %+v", err)
+	}
+
+	return state
+}
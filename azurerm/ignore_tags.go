@@ -0,0 +1,208 @@
+package azurerm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// ignoreTagsConfig contains the tag keys which are managed outside of Terraform (for example by
+// Azure Policy or Azure Backup) and should be ignored by every resource.
+type ignoreTagsConfig struct {
+	keys        []string
+	keyPrefixes []string
+}
+
+func expandProviderIgnoreTags(input []interface{}) ignoreTagsConfig {
+	config := ignoreTagsConfig{
+		keys:        make([]string, 0),
+		keyPrefixes: make([]string, 0),
+	}
+	if len(input) == 0 || input[0] == nil {
+		return config
+	}
+
+	block := input[0].(map[string]interface{})
+	for _, v := range block["keys"].([]interface{}) {
+		config.keys = append(config.keys, v.(string))
+	}
+	for _, v := range block["key_prefixes"].([]interface{}) {
+		config.keyPrefixes = append(config.keyPrefixes, v.(string))
+	}
+
+	return config
+}
+
+func (c ignoreTagsConfig) isEmpty() bool {
+	return len(c.keys) == 0 && len(c.keyPrefixes) == 0
+}
+
+// isIgnored returns whether the tag with the specified key should be ignored. Tag keys are
+// case-insensitive in Azure, so they're compared as such.
+func (c ignoreTagsConfig) isIgnored(key string) bool {
+	for _, v := range c.keys {
+		if strings.EqualFold(key, v) {
+			return true
+		}
+	}
+
+	for _, v := range c.keyPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withIgnoredTagsPreserved returns a SendDecorator which ensures tags matching `ignore_tags` are left
+// in place when a resource is updated. Since tags are replaced in their entirety when a resource is
+// PUT (or PATCH'd) and ignored tags are never written to the state - the current tags are retrieved
+// from the resource and any ignored tags which aren't being set are merged into the request. If the
+// current tags can't be retrieved an error is returned, rather than removing the ignored tags.
+func (c *ArmClient) withIgnoredTagsPreserved() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if c.ignoreTags.isEmpty() || r.Body == nil || (r.Method != http.MethodPut && r.Method != http.MethodPatch) {
+				return s.Do(r)
+			}
+
+			// only Resource Manager resources have tags - requests to data plane APIs (such as Key Vault
+			// Secrets) are sent as-is
+			if !c.isResourceManagerRequest(r) {
+				return s.Do(r)
+			}
+
+			body, err := ioutil.ReadAll(r.Body)
+			r.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+
+			updated, ok, err := c.mergeIgnoredTags(s, r, body)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				body = updated
+			}
+
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			r.ContentLength = int64(len(body))
+			return s.Do(r)
+		})
+	}
+}
+
+// isResourceManagerRequest returns whether the request is for a resource within Resource Manager, rather than
+// to a data plane API
+func (c *ArmClient) isResourceManagerRequest(r *http.Request) bool {
+	endpoint, err := url.Parse(c.resourceManagerEndpoint)
+	if err != nil || endpoint.Host == "" {
+		return false
+	}
+
+	return strings.EqualFold(r.URL.Host, endpoint.Host) && strings.HasPrefix(strings.ToLower(r.URL.Path), "/subscriptions/")
+}
+
+// mergeIgnoredTags merges the ignored tags currently assigned to the resource into the request body,
+// returning the updated body and whether it was changed.
+func (c *ArmClient) mergeIgnoredTags(s autorest.Sender, r *http.Request, body []byte) ([]byte, bool, error) {
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, false, nil
+	}
+
+	rawTags, ok := payload["tags"]
+	if !ok {
+		return nil, false, nil
+	}
+
+	tags := make(map[string]*string)
+	if err := json.Unmarshal(rawTags, &tags); err != nil {
+		return nil, false, nil
+	}
+
+	existing, err := retrieveExistingTags(s, r)
+	if err != nil {
+		return nil, false, fmt.Errorf("Error retrieving the existing tags for %s (to preserve the tags matching `ignore_tags`): %+v", r.URL, err)
+	}
+
+	// Tag keys are case-insensitive in Azure, so a tag which is being set using a different case isn't preserved
+	keys := make(map[string]struct{}, len(tags))
+	for key := range tags {
+		keys[strings.ToLower(key)] = struct{}{}
+	}
+
+	changed := false
+	for key, value := range existing {
+		if !c.ignoreTags.isIgnored(key) {
+			continue
+		}
+
+		if _, exists := keys[strings.ToLower(key)]; !exists {
+			log.Printf("[DEBUG] Preserving the ignored tag %q on %s", key, r.URL)
+			tags[key] = value
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil, false, nil
+	}
+
+	updatedTags, err := json.Marshal(tags)
+	if err != nil {
+		return nil, false, err
+	}
+	payload["tags"] = updatedTags
+
+	updated, err := json.Marshal(payload)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return updated, true, nil
+}
+
+// retrieveExistingTags retrieves the tags currently assigned to the resource the request is for - which
+// are empty when the resource doesn't exist yet.
+func retrieveExistingTags(s autorest.Sender, r *http.Request) (map[string]*string, error) {
+	req, err := http.NewRequest(http.MethodGet, r.URL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(r.Context())
+
+	for key, values := range r.Header {
+		if strings.EqualFold(key, "Content-Type") || strings.EqualFold(key, "Content-Length") {
+			continue
+		}
+		req.Header[key] = values
+	}
+
+	resp, err := s.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing struct {
+		Tags map[string]*string `json:"tags"`
+	}
+	err = autorest.Respond(resp,
+		autorest.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNotFound),
+		autorest.ByUnmarshallingJSON(&existing),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, err
+	}
+
+	return existing.Tags, nil
+}
@@ -0,0 +1,244 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestIgnoreTagsConfigIsIgnored(t *testing.T) {
+	config := ignoreTagsConfig{
+		keys:        []string{"ms-resource-usage"},
+		keyPrefixes: []string{"hidden-link:"},
+	}
+
+	testCases := map[string]bool{
+		"ms-resource-usage":                  true,
+		"MS-Resource-Usage":                  true,
+		"ms-resource-usage-2":                false,
+		"hidden-link:/subscriptions/example": true,
+		"Hidden-Link:/subscriptions/example": true,
+		"hidden-title":                       false,
+		"environment":                        false,
+	}
+
+	for key, expected := range testCases {
+		if actual := config.isIgnored(key); actual != expected {
+			t.Fatalf("Expected %q to be ignored: %t but got %t", key, expected, actual)
+		}
+	}
+
+	if (ignoreTagsConfig{}).isIgnored("environment") {
+		t.Fatalf("Expected no tags to be ignored when `ignore_tags` isn't configured")
+	}
+}
+
+func TestFlattenAndSetTagsWithIgnoreTags(t *testing.T) {
+	client := &ArmClient{
+		ignoreTags: ignoreTagsConfig{
+			keys:        []string{"ms-resource-usage"},
+			keyPrefixes: []string{"hidden-link:"},
+		},
+	}
+
+	d := resourceArmResourceGroup().TestResourceData()
+	tags := map[string]*string{
+		"environment":                        utils.String("production"),
+		"ms-resource-usage":                  utils.String("azure-cloud-shell"),
+		"hidden-link:/subscriptions/example": utils.String("Resource"),
+	}
+	flattenAndSetTags(d, &tags, client)
+
+	actual := d.Get("tags").(map[string]interface{})
+	if len(actual) != 1 || actual["environment"] != "production" {
+		t.Fatalf("Expected only the `environment` tag to be set but got %+v", actual)
+	}
+}
+
+const testIgnoreTagsResourcePath = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Network/virtualNetworks/example-network"
+
+func TestWithIgnoredTagsPreserved(t *testing.T) {
+	var sentBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"location":"westeurope","tags":{"environment":"staging","hidden-link:/app":"Resource","ms-resource-usage":"backup"}}`))
+		case http.MethodPut:
+			body, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(body, &sentBody)
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := &ArmClient{
+		resourceManagerEndpoint: server.URL,
+		ignoreTags: ignoreTagsConfig{
+			keys:        []string{"ms-resource-usage"},
+			keyPrefixes: []string{"hidden-link:"},
+		},
+	}
+	sender := autorest.CreateSender(client.withIgnoredTagsPreserved())
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL+testIgnoreTagsResourcePath, strings.NewReader(`{"location":"westeurope","properties":{"value":12345678901234567890},"tags":{"environment":"production"}}`))
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	tags := sentBody["tags"].(map[string]interface{})
+	expected := map[string]string{
+		"environment":       "production",
+		"hidden-link:/app":  "Resource",
+		"ms-resource-usage": "backup",
+	}
+	if len(tags) != len(expected) {
+		t.Fatalf("Expected %d tags to be sent but got %d: %+v", len(expected), len(tags), tags)
+	}
+	for k, v := range expected {
+		if tags[k] != v {
+			t.Fatalf("Expected the tag %q to be sent as %q but got %v", k, v, tags[k])
+		}
+	}
+
+	if _, ok := sentBody["properties"]; !ok {
+		t.Fatalf("Expected the remainder of the body to be sent but got %+v", sentBody)
+	}
+}
+
+func TestWithIgnoredTagsPreserved_NewResource(t *testing.T) {
+	var sentBody string
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		sentBody = string(body)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := &ArmClient{
+		resourceManagerEndpoint: server.URL,
+		ignoreTags: ignoreTagsConfig{
+			keyPrefixes: []string{"hidden-link:"},
+		},
+	}
+	sender := autorest.CreateSender(client.withIgnoredTagsPreserved())
+
+	expected := `{"location":"westeurope","tags":{"environment":"production"}}`
+	req, _ := http.NewRequest(http.MethodPut, server.URL+testIgnoreTagsResourcePath, strings.NewReader(expected))
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if sentBody != expected {
+		t.Fatalf("Expected the body to be sent unchanged as %q but got %q", expected, sentBody)
+	}
+
+	if requests != 2 {
+		t.Fatalf("Expected 2 requests but got %d", requests)
+	}
+}
+
+func TestWithIgnoredTagsPreserved_DifferentCase(t *testing.T) {
+	var sentBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"location":"westeurope","tags":{"ms-resource-usage":"backup"}}`))
+		case http.MethodPut:
+			body, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(body, &sentBody)
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := &ArmClient{
+		resourceManagerEndpoint: server.URL,
+		ignoreTags: ignoreTagsConfig{
+			keys: []string{"ms-resource-usage"},
+		},
+	}
+	sender := autorest.CreateSender(client.withIgnoredTagsPreserved())
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL+testIgnoreTagsResourcePath, strings.NewReader(`{"location":"westeurope","tags":{"MS-Resource-Usage":"archive"}}`))
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	tags := sentBody["tags"].(map[string]interface{})
+	if len(tags) != 1 || tags["MS-Resource-Usage"] != "archive" {
+		t.Fatalf("Expected only the `MS-Resource-Usage` tag to be sent but got %+v", tags)
+	}
+}
+
+func TestWithIgnoredTagsPreserved_RetrievalFails(t *testing.T) {
+	methods := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &ArmClient{
+		resourceManagerEndpoint: server.URL,
+		ignoreTags: ignoreTagsConfig{
+			keyPrefixes: []string{"hidden-link:"},
+		},
+	}
+	sender := autorest.CreateSender(client.withIgnoredTagsPreserved())
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL+testIgnoreTagsResourcePath, strings.NewReader(`{"location":"westeurope","tags":{"environment":"production"}}`))
+	if _, err := sender.Do(req); err == nil {
+		t.Fatalf("Expected an error when the existing tags can't be retrieved but didn't get one")
+	}
+
+	if len(methods) != 1 || methods[0] != http.MethodGet {
+		t.Fatalf("Expected only the GET request to be sent but got %+v", methods)
+	}
+}
+
+func TestWithIgnoredTagsPreserved_DataPlane(t *testing.T) {
+	methods := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &ArmClient{
+		resourceManagerEndpoint: "https://management.azure.com/",
+		ignoreTags: ignoreTagsConfig{
+			keyPrefixes: []string{"hidden-link:"},
+		},
+	}
+	sender := autorest.CreateSender(client.withIgnoredTagsPreserved())
+
+	// e.g. a Key Vault Secret
+	req, _ := http.NewRequest(http.MethodPut, server.URL+"/secrets/example", strings.NewReader(`{"value":"hunter2","tags":{"environment":"production"}}`))
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if len(methods) != 1 || methods[0] != http.MethodPut {
+		t.Fatalf("Expected only the PUT request to be sent but got %+v", methods)
+	}
+}
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMAppServicePlan_importBasicWindows(t *testing.T) {
 	resourceName := "azurerm_app_service_plan.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServicePlan_basicWindows(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -33,7 +32,7 @@ func TestAccAzureRMAppServicePlan_importBasicWindows(t *testing.T) {
 func TestAccAzureRMAppServicePlan_importWindowsStandard(t *testing.T) {
 	resourceName := "azurerm_app_service_plan.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServicePlan_standardWindows(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -56,7 +55,7 @@ func TestAccAzureRMAppServicePlan_importWindowsStandard(t *testing.T) {
 func TestAccAzureRMAppServicePlan_importPremiumWindows(t *testing.T) {
 	resourceName := "azurerm_app_service_plan.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServicePlan_premiumWindows(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -79,7 +78,7 @@ func TestAccAzureRMAppServicePlan_importPremiumWindows(t *testing.T) {
 func TestAccAzureRMAppServicePlan_importCompleteWindows(t *testing.T) {
 	resourceName := "azurerm_app_service_plan.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServicePlan_completeWindows(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
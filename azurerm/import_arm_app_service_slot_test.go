@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMAppServiceSlot_importBasic(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
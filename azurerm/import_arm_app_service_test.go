@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMAppService_importBasic(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -33,7 +32,7 @@ func TestAccAzureRMAppService_importBasic(t *testing.T) {
 func TestAccAzureRMAppService_import32Bit(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_32Bit(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -56,7 +55,7 @@ func TestAccAzureRMAppService_import32Bit(t *testing.T) {
 func TestAccAzureRMAppService_importAlwaysOn(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_alwaysOn(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -79,7 +78,7 @@ func TestAccAzureRMAppService_importAlwaysOn(t *testing.T) {
 func TestAccAzureRMAppService_importAppSettings(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_appSettings(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -102,7 +101,7 @@ func TestAccAzureRMAppService_importAppSettings(t *testing.T) {
 func TestAccAzureRMAppService_importClientAffinityEnabled(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_clientAffinityEnabled(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -125,7 +124,7 @@ func TestAccAzureRMAppService_importClientAffinityEnabled(t *testing.T) {
 func TestAccAzureRMAppService_importConnectionStrings(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_connectionStrings(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -148,7 +147,7 @@ func TestAccAzureRMAppService_importConnectionStrings(t *testing.T) {
 func TestAccAzureRMAppService_importDefaultDocuments(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_defaultDocuments(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -171,7 +170,7 @@ func TestAccAzureRMAppService_importDefaultDocuments(t *testing.T) {
 func TestAccAzureRMAppService_importEnabled(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_enabled(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -194,7 +193,7 @@ func TestAccAzureRMAppService_importEnabled(t *testing.T) {
 func TestAccAzureRMAppService_importLocalMySql(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_localMySql(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -217,7 +216,7 @@ func TestAccAzureRMAppService_importLocalMySql(t *testing.T) {
 func TestAccAzureRMAppService_importManagedPipelineMode(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_managedPipelineMode(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -240,7 +239,7 @@ func TestAccAzureRMAppService_importManagedPipelineMode(t *testing.T) {
 func TestAccAzureRMAppService_importRemoteDebugging(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_remoteDebugging(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -263,7 +262,7 @@ func TestAccAzureRMAppService_importRemoteDebugging(t *testing.T) {
 func TestAccAzureRMAppService_importWindowsDotNet2(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsDotNet(ri, testLocation(), "v2.0")
 
 	resource.Test(t, resource.TestCase{
@@ -286,7 +285,7 @@ func TestAccAzureRMAppService_importWindowsDotNet2(t *testing.T) {
 func TestAccAzureRMAppService_importWindowsDotNet4(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsDotNet(ri, testLocation(), "v4.0")
 
 	resource.Test(t, resource.TestCase{
@@ -309,7 +308,7 @@ func TestAccAzureRMAppService_importWindowsDotNet4(t *testing.T) {
 func TestAccAzureRMAppService_importWindowsJava7Jetty(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsJava(ri, testLocation(), "1.7", "JETTY", "9.3")
 
 	resource.Test(t, resource.TestCase{
@@ -332,7 +331,7 @@ func TestAccAzureRMAppService_importWindowsJava7Jetty(t *testing.T) {
 func TestAccAzureRMAppService_importWindowsJava8Jetty(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsJava(ri, testLocation(), "1.8", "JETTY", "9.3")
 
 	resource.Test(t, resource.TestCase{
@@ -355,7 +354,7 @@ func TestAccAzureRMAppService_importWindowsJava8Jetty(t *testing.T) {
 func TestAccAzureRMAppService_importWindowsJava7Tomcat(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsJava(ri, testLocation(), "1.7", "TOMCAT", "9.0")
 
 	resource.Test(t, resource.TestCase{
@@ -378,7 +377,7 @@ func TestAccAzureRMAppService_importWindowsJava7Tomcat(t *testing.T) {
 func TestAccAzureRMAppService_importWindowsJava8Tomcat(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsJava(ri, testLocation(), "1.8", "TOMCAT", "9.0")
 
 	resource.Test(t, resource.TestCase{
@@ -401,7 +400,7 @@ func TestAccAzureRMAppService_importWindowsJava8Tomcat(t *testing.T) {
 func TestAccAzureRMAppService_importWindowsPHP7(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsPHP(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -424,7 +423,7 @@ func TestAccAzureRMAppService_importWindowsPHP7(t *testing.T) {
 func TestAccAzureRMAppService_importWindowsPython(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsPython(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -447,7 +446,7 @@ func TestAccAzureRMAppService_importWindowsPython(t *testing.T) {
 func TestAccAzureRMAppService_importWebSockets(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_webSockets(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAzureRMApplicationGateway_importBasic(t *testing.T) {
 	resourceName := "azurerm_application_gateway.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMApplicationGateway_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
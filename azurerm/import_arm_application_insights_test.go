@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMApplicationInsights_importBasicWeb(t *testing.T) {
 	resourceName := "azurerm_application_insights.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMApplicationInsights_basicWeb(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -34,7 +33,7 @@ func TestAccAzureRMApplicationInsights_importBasicWeb(t *testing.T) {
 func TestAccAzureRMApplicationInsights_importBasicOther(t *testing.T) {
 	resourceName := "azurerm_application_insights.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMApplicationInsights_basicWeb(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMAutomationAccount_importBasic(t *testing.T) {
 	resourceName := "azurerm_automation_account.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAutomationAccount_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -34,7 +33,7 @@ func TestAccAzureRMAutomationAccount_importBasic(t *testing.T) {
 func TestAccAzureRMAutomationAccount_importComplete(t *testing.T) {
 	resourceName := "azurerm_automation_account.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAutomationAccount_complete(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
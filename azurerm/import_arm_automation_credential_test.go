@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMAutomationCredential_importCredential(t *testing.T) {
 	resourceName := "azurerm_automation_credential.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAutomationCredential_complete(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
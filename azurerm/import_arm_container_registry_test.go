@@ -3,15 +3,14 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMContainerRegistry_importBasicClassic(t *testing.T) {
 	resourceName := "azurerm_container_registry.test"
 
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	config := testAccAzureRMContainerRegistry_basicUnmanaged(ri, rs, testLocation(), "Classic")
 
 	resource.Test(t, resource.TestCase{
@@ -36,7 +35,7 @@ func TestAccAzureRMContainerRegistry_importBasicClassic(t *testing.T) {
 func TestAccAzureRMContainerRegistry_importBasicBasic(t *testing.T) {
 	resourceName := "azurerm_container_registry.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMContainerRegistry_basicManaged(ri, testLocation(), "Basic")
 
 	resource.Test(t, resource.TestCase{
@@ -61,7 +60,7 @@ func TestAccAzureRMContainerRegistry_importBasicBasic(t *testing.T) {
 func TestAccAzureRMContainerRegistry_importBasicManagedStandard(t *testing.T) {
 	resourceName := "azurerm_container_registry.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMContainerRegistry_basicManaged(ri, testLocation(), "Standard")
 
 	resource.Test(t, resource.TestCase{
@@ -86,7 +85,7 @@ func TestAccAzureRMContainerRegistry_importBasicManagedStandard(t *testing.T) {
 func TestAccAzureRMContainerRegistry_importBasicManagedPremium(t *testing.T) {
 	resourceName := "azurerm_container_registry.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMContainerRegistry_basicManaged(ri, testLocation(), "Premium")
 
 	resource.Test(t, resource.TestCase{
@@ -111,8 +110,8 @@ func TestAccAzureRMContainerRegistry_importBasicManagedPremium(t *testing.T) {
 func TestAccAzureRMContainerRegistry_importComplete(t *testing.T) {
 	resourceName := "azurerm_container_registry.test"
 
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	config := testAccAzureRMContainerRegistry_complete(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMCosmosDBAccount_importBoundedStaleness(t *testing.T) {
 	resourceName := "azurerm_cosmosdb_account.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_boundedStaleness(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -34,7 +33,7 @@ func TestAccAzureRMCosmosDBAccount_importBoundedStaleness(t *testing.T) {
 func TestAccAzureRMCosmosDBAccount_importBoundedStalenessComplete(t *testing.T) {
 	resourceName := "azurerm_cosmosdb_account.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_boundedStalenessComplete(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -58,7 +57,7 @@ func TestAccAzureRMCosmosDBAccount_importBoundedStalenessComplete(t *testing.T)
 func TestAccAzureRMCosmosDBAccount_importEventualConsistency(t *testing.T) {
 	resourceName := "azurerm_cosmosdb_account.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_eventualConsistency(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -82,7 +81,7 @@ func TestAccAzureRMCosmosDBAccount_importEventualConsistency(t *testing.T) {
 func TestAccAzureRMCosmosDBAccount_importMongoDB(t *testing.T) {
 	resourceName := "azurerm_cosmosdb_account.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_mongoDB(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -106,7 +105,7 @@ func TestAccAzureRMCosmosDBAccount_importMongoDB(t *testing.T) {
 func TestAccAzureRMCosmosDBAccount_importSession(t *testing.T) {
 	resourceName := "azurerm_cosmosdb_account.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_session(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -130,7 +129,7 @@ func TestAccAzureRMCosmosDBAccount_importSession(t *testing.T) {
 func TestAccAzureRMCosmosDBAccount_importStrong(t *testing.T) {
 	resourceName := "azurerm_cosmosdb_account.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_strong(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -154,7 +153,7 @@ func TestAccAzureRMCosmosDBAccount_importStrong(t *testing.T) {
 func TestAccAzureRMCosmosDBAccount_importGeoReplicated(t *testing.T) {
 	resourceName := "azurerm_cosmosdb_account.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_geoReplicated(ri, testLocation(), testAltLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMDnsARecord_importBasic(t *testing.T) {
 	resourceName := "azurerm_dns_a_record.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMDnsARecord_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -32,7 +31,7 @@ func TestAccAzureRMDnsARecord_importBasic(t *testing.T) {
 func TestAccAzureRMDnsARecord_importWithTags(t *testing.T) {
 	resourceName := "azurerm_dns_a_record.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMDnsARecord_withTags(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMDnsTxtRecord_importBasic(t *testing.T) {
 	resourceName := "azurerm_dns_txt_record.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMDnsTxtRecord_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -33,7 +32,7 @@ func TestAccAzureRMDnsTxtRecord_importBasic(t *testing.T) {
 func TestAccAzureRMDnsTxtRecord_importWithTags(t *testing.T) {
 	resourceName := "azurerm_dns_txt_record.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMDnsTxtRecord_withTags(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,12 +3,11 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMEventGridTopic_importBasic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -29,7 +28,7 @@ func TestAccAzureRMEventGridTopic_importBasic(t *testing.T) {
 }
 
 func TestAccAzureRMEventGridTopic_importBasicWithTags(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
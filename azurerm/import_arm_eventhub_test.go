@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMEventHub_importBasic(t *testing.T) {
 	resourceName := "azurerm_eventhub.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMEventHub_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -34,8 +33,8 @@ func TestAccAzureRMEventHub_importBasic(t *testing.T) {
 func TestAccAzureRMEventHub_importCaptureDescription(t *testing.T) {
 	resourceName := "azurerm_eventhub.test"
 
-	ri := acctest.RandInt()
-	rs := acctest.RandString(5)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 5)
 	config := testAccAzureRMEventHub_captureDescription(ri, rs, testLocation(), true)
 
 	resource.Test(t, resource.TestCase{
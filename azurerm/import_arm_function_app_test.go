@@ -3,15 +3,14 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMFunctionApp_importBasic(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 
-	ri := acctest.RandInt()
-	rs := acctest.RandString(5)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 5)
 	config := testAccAzureRMFunctionApp_basic(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -34,8 +33,8 @@ func TestAccAzureRMFunctionApp_importBasic(t *testing.T) {
 func TestAccAzureRMFunctionApp_importTags(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 
-	ri := acctest.RandInt()
-	rs := acctest.RandString(5)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 5)
 	config := testAccAzureRMFunctionApp_tags(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -58,8 +57,8 @@ func TestAccAzureRMFunctionApp_importTags(t *testing.T) {
 func TestAccAzureRMFunctionApp_importAppSettings(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
 
-	ri := acctest.RandInt()
-	rs := acctest.RandString(5)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 5)
 	config := testAccAzureRMFunctionApp_appSettings(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMKeyVaultCertificate_importPFX(t *testing.T) {
 	resourceName := "azurerm_key_vault_certificate.test"
 
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultCertificate_basicImportPFX(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -34,7 +33,7 @@ func TestAccAzureRMKeyVaultCertificate_importPFX(t *testing.T) {
 func TestAccAzureRMKeyVaultCertificate_importGenerated(t *testing.T) {
 	resourceName := "azurerm_key_vault_certificate.test"
 
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultCertificate_basicGenerate(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
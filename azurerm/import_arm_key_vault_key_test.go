@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMKeyVaultKey_importBasicEC(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
 
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultKey_basicEC(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -34,7 +33,7 @@ func TestAccAzureRMKeyVaultKey_importBasicEC(t *testing.T) {
 func TestAccAzureRMKeyVaultKey_importBasicRSA(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
 
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultKey_basicRSA(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -58,7 +57,7 @@ func TestAccAzureRMKeyVaultKey_importBasicRSA(t *testing.T) {
 func TestAccAzureRMKeyVaultKey_importBasicRSAHSM(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
 
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultKey_basicRSAHSM(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -82,7 +81,7 @@ func TestAccAzureRMKeyVaultKey_importBasicRSAHSM(t *testing.T) {
 func TestAccAzureRMKeyVaultKey_importComplete(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
 
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultKey_complete(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
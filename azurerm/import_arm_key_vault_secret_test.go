@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMKeyVaultSecret_importBasic(t *testing.T) {
 	resourceName := "azurerm_key_vault_secret.test"
 
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultSecret_basic(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -33,7 +32,7 @@ func TestAccAzureRMKeyVaultSecret_importBasic(t *testing.T) {
 func TestAccAzureRMKeyVaultSecret_importComplete(t *testing.T) {
 	resourceName := "azurerm_key_vault_secret.test"
 
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultSecret_complete(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -4,14 +4,13 @@ import (
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMKubernetesCluster_importBasic(t *testing.T) {
 	resourceName := "azurerm_kubernetes_cluster.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	clientId := os.Getenv("ARM_CLIENT_ID")
 	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
 	config := testAccAzureRMKubernetesCluster_basic(ri, clientId, clientSecret, testLocation())
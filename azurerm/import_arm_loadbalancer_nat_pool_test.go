@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMLoadBalancerNatPool_importBasic(t *testing.T) {
 	resourceName := "azurerm_lb_nat_pool.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	natPoolName := fmt.Sprintf("NatPool-%d", ri)
 
 	resource.Test(t, resource.TestCase{
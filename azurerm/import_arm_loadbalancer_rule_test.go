@@ -11,8 +11,8 @@ import (
 func TestAccAzureRMLoadBalancerRule_importBasic(t *testing.T) {
 	resourceName := "azurerm_lb_rule.test"
 
-	ri := acctest.RandInt()
-	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	ri := testAccRandInt(t)
+	lbRuleName := fmt.Sprintf("LbRule-%s", testAccRandStringFromCharSet(t, 8, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -3,13 +3,12 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMLoadBalancer_importBasic(t *testing.T) {
 	resourceName := "azurerm_lb.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMLoadBalancer_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -31,7 +30,7 @@ func TestAccAzureRMLoadBalancer_importBasic(t *testing.T) {
 
 func TestAccAzureRMLoadBalancer_importFrontEnd(t *testing.T) {
 	resourceName := "azurerm_lb.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMLoadBalancer_frontEndConfig(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMLogAnalyticsWorkspace_importRequiredOnly(t *testing.T) {
 	resourceName := "azurerm_log_analytics_workspace.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMLogAnalyticsWorkspace_requiredOnly(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -34,7 +33,7 @@ func TestAccAzureRMLogAnalyticsWorkspace_importRequiredOnly(t *testing.T) {
 func TestAccAzureRMLogAnalyticsWorkspace_importRetentionInDaysComplete(t *testing.T) {
 	resourceName := "azurerm_log_analytics_workspace.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMLogAnalyticsWorkspace_retentionInDaysComplete(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
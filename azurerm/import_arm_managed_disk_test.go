@@ -3,12 +3,11 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMManagedDisk_importEmpty(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMManagedDisk_empty(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
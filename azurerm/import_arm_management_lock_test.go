@@ -3,12 +3,11 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMManagementLock_importResourceGroupReadOnlyBasic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMManagementLock_resourceGroupReadOnlyBasic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -29,7 +28,7 @@ func TestAccAzureRMManagementLock_importResourceGroupReadOnlyBasic(t *testing.T)
 }
 
 func TestAccAzureRMManagementLock_importResourceGroupReadOnlyComplete(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMManagementLock_resourceGroupReadOnlyComplete(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -50,7 +49,7 @@ func TestAccAzureRMManagementLock_importResourceGroupReadOnlyComplete(t *testing
 }
 
 func TestAccAzureRMManagementLock_importResourceGroupCanNotDeleteBasic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMManagementLock_resourceGroupCanNotDeleteBasic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -71,7 +70,7 @@ func TestAccAzureRMManagementLock_importResourceGroupCanNotDeleteBasic(t *testin
 }
 
 func TestAccAzureRMManagementLock_importResourceGroupCanNotDeleteComplete(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMManagementLock_resourceGroupCanNotDeleteComplete(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -92,7 +91,7 @@ func TestAccAzureRMManagementLock_importResourceGroupCanNotDeleteComplete(t *tes
 }
 
 func TestAccAzureRMManagementLock_importPublicIPCanNotDeleteBasic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMManagementLock_publicIPCanNotDeleteBasic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -113,7 +112,7 @@ func TestAccAzureRMManagementLock_importPublicIPCanNotDeleteBasic(t *testing.T)
 }
 
 func TestAccAzureRMManagementLock_importPublicIPReadOnlyBasic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMManagementLock_publicIPReadOnlyBasic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
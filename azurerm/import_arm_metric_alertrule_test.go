@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMMetricAlertRule_importVirtualMachineCpu(t *testing.T) {
 	resourceName := "azurerm_metric_alertrule.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMMetricAlertRule_virtualMachineCpu(ri, testLocation(), true)
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMMySQLConfiguration_importCharacterSetServer(t *testing.T) {
 	resourceName := "azurerm_mysql_configuration.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMMySQLConfiguration_characterSetServer(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -33,7 +32,7 @@ func TestAccAzureRMMySQLConfiguration_importCharacterSetServer(t *testing.T) {
 func TestAccAzureRMPostgreSQLConfiguration_importInteractiveTimeout(t *testing.T) {
 	resourceName := "azurerm_mysql_configuration.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMMySQLConfiguration_interactiveTimeout(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -56,7 +55,7 @@ func TestAccAzureRMPostgreSQLConfiguration_importInteractiveTimeout(t *testing.T
 func TestAccAzureRMPostgreSQLConfiguration_importLogSlowAdminStatements(t *testing.T) {
 	resourceName := "azurerm_mysql_configuration.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMMySQLConfiguration_logSlowAdminStatements(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMMySQLDatabase_importBasic(t *testing.T) {
 	resourceName := "azurerm_mysql_database.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMMySQLDatabase_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMMySQLFirewallRule_importBasic(t *testing.T) {
 	resourceName := "azurerm_mysql_firewall_rule.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMMySQLFirewallRule_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
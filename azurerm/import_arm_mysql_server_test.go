@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMMySQLServer_importBasicFiveSix(t *testing.T) {
 	resourceName := "azurerm_mysql_server.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMMySQLServer_basicFiveSix(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -36,7 +35,7 @@ func TestAccAzureRMMySQLServer_importBasicFiveSix(t *testing.T) {
 func TestAccAzureRMMySQLServer_importBasicFiveSeven(t *testing.T) {
 	resourceName := "azurerm_mysql_server.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMMySQLServer_basicFiveSeven(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -62,7 +61,7 @@ func TestAccAzureRMMySQLServer_importBasicFiveSeven(t *testing.T) {
 func TestAccAzureRMMySQLServer_importStandard(t *testing.T) {
 	resourceName := "azurerm_mysql_server.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMMySQLServer_standard(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,12 +3,11 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMNetworkWatcher_importBasic(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	resourceName := "azurerm_network_watcher.test"
 
 	resource.Test(t, resource.TestCase{
@@ -29,7 +28,7 @@ func TestAccAzureRMNetworkWatcher_importBasic(t *testing.T) {
 }
 
 func TestAccAzureRMNetworkWatcher_importComplete(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	resourceName := "azurerm_network_watcher.test"
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMPostgreSQLConfiguration_importBackslashQuote(t *testing.T) {
 	resourceName := "azurerm_postgresql_configuration.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMPostgreSQLConfiguration_backslashQuote(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -33,7 +32,7 @@ func TestAccAzureRMPostgreSQLConfiguration_importBackslashQuote(t *testing.T) {
 func TestAccAzureRMPostgreSQLConfiguration_importClientMinMessages(t *testing.T) {
 	resourceName := "azurerm_postgresql_configuration.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMPostgreSQLConfiguration_clientMinMessages(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -56,7 +55,7 @@ func TestAccAzureRMPostgreSQLConfiguration_importClientMinMessages(t *testing.T)
 func TestAccAzureRMPostgreSQLConfiguration_importDeadlockTimeout(t *testing.T) {
 	resourceName := "azurerm_postgresql_configuration.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMPostgreSQLConfiguration_deadlockTimeout(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMPostgreSQLDatabase_importBasic(t *testing.T) {
 	resourceName := "azurerm_postgresql_database.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMPostgreSQLDatabase_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
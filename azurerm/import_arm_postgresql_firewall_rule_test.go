@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMPostgreSQLFirewallRule_importBasic(t *testing.T) {
 	resourceName := "azurerm_postgresql_firewall_rule.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMPostgreSQLFirewallRule_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMPostgreSQLServer_importBasicNinePointFive(t *testing.T) {
 	resourceName := "azurerm_postgresql_server.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMPostgreSQLServer_basicNinePointFive(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -36,7 +35,7 @@ func TestAccAzureRMPostgreSQLServer_importBasicNinePointFive(t *testing.T) {
 func TestAccAzureRMPostgreSQLServer_importBasicNinePointSix(t *testing.T) {
 	resourceName := "azurerm_postgresql_server.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMPostgreSQLServer_basicNinePointSix(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -62,7 +61,7 @@ func TestAccAzureRMPostgreSQLServer_importBasicNinePointSix(t *testing.T) {
 func TestAccAzureRMPostgreSQLServer_importStandard(t *testing.T) {
 	resourceName := "azurerm_postgresql_server.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMPostgreSQLServer_standard(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
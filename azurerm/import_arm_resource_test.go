@@ -0,0 +1,32 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMResource_importBasic(t *testing.T) {
+	resourceName := "azurerm_resource.test"
+
+	ri := testAccRandInt(t)
+	config := testAccAzureRMResource_basic(ri, testLocation(), "10.0.0.0/16")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the imported body contains all of the properties returned by Azure, and the API version is the latest
+				ImportStateVerifyIgnore: []string{"api_version", "body", "outputs", "response_export_values"},
+			},
+		},
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
@@ -13,7 +12,7 @@ func TestAccAzureRMRoleAssignment_importBasic(t *testing.T) {
 
 	roleDefinitionId := uuid.New().String()
 	roleAssignmentId := uuid.New().String()
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRoleAssignment_custom(roleDefinitionId, roleAssignmentId, ri)
 
 	resource.Test(t, resource.TestCase{
@@ -38,7 +37,7 @@ func TestAccAzureRMRoleAssignment_importCustom(t *testing.T) {
 
 	roleDefinitionId := uuid.New().String()
 	roleAssignmentId := uuid.New().String()
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRoleAssignment_custom(roleDefinitionId, roleAssignmentId, ri)
 
 	resource.Test(t, resource.TestCase{
@@ -4,7 +4,6 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
@@ -12,7 +11,7 @@ func TestAccAzureRMRoleDefinition_importBasic(t *testing.T) {
 	resourceName := "azurerm_role_definition.test"
 
 	id := uuid.New().String()
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRoleDefinition_basic(id, ri)
 
 	resource.Test(t, resource.TestCase{
@@ -37,7 +36,7 @@ func TestAccAzureRMRoleDefinition_importComplete(t *testing.T) {
 	resourceName := "azurerm_role_definition.test"
 
 	id := uuid.New().String()
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRoleDefinition_complete(id, ri)
 
 	resource.Test(t, resource.TestCase{
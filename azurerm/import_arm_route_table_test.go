@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMRouteTable_importBasic(t *testing.T) {
 	resourceName := "azurerm_route_table.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRouteTable_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -33,7 +32,7 @@ func TestAccAzureRMRouteTable_importBasic(t *testing.T) {
 func TestAccAzureRMRouteTable_importSingleRoute(t *testing.T) {
 	resourceName := "azurerm_route_table.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRouteTable_singleRoute(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -56,7 +55,7 @@ func TestAccAzureRMRouteTable_importSingleRoute(t *testing.T) {
 func TestAccAzureRMRouteTable_importMultipleRoutes(t *testing.T) {
 	resourceName := "azurerm_route_table.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRouteTable_multipleRoutes(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMServiceBusTopicAuthorizationRule_importListen(t *testing.T) {
 	resourceName := "azurerm_servicebus_topic_authorization_rule.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMServiceBusTopicAuthorizationRule_listen(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -34,7 +33,7 @@ func TestAccAzureRMServiceBusTopicAuthorizationRule_importListen(t *testing.T) {
 func TestAccAzureRMServiceBusTopicAuthorizationRule_importSend(t *testing.T) {
 	resourceName := "azurerm_servicebus_topic_authorization_rule.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMServiceBusTopicAuthorizationRule_send(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -58,7 +57,7 @@ func TestAccAzureRMServiceBusTopicAuthorizationRule_importSend(t *testing.T) {
 func TestAccAzureRMServiceBusTopicAuthorizationRule_importReadWrite(t *testing.T) {
 	resourceName := "azurerm_servicebus_topic_authorization_rule.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMServiceBusTopicAuthorizationRule_readWrite(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -82,7 +81,7 @@ func TestAccAzureRMServiceBusTopicAuthorizationRule_importReadWrite(t *testing.T
 func TestAccAzureRMServiceBusTopicAuthorizationRule_importManage(t *testing.T) {
 	resourceName := "azurerm_servicebus_topic_authorization_rule.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMServiceBusTopicAuthorizationRule_manage(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,13 +3,12 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMSnapshot_import(t *testing.T) {
 	resourceName := "azurerm_snapshot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMSnapshot_fromManagedDisk(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -32,8 +31,8 @@ func TestAccAzureRMSnapshot_import(t *testing.T) {
 
 func TestAccAzureRMSnapshot_importEncryption(t *testing.T) {
 	resourceName := "azurerm_snapshot.test"
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	config := testAccAzureRMSnapshot_encryption(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMSqlDatabase_importBasic(t *testing.T) {
 	resourceName := "azurerm_sql_database.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMSqlDatabase_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -34,7 +33,7 @@ func TestAccAzureRMSqlDatabase_importBasic(t *testing.T) {
 func TestAccAzureRMSqlDatabase_importDataWarehouse(t *testing.T) {
 	resourceName := "azurerm_sql_database.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMSqlDatabase_dataWarehouse(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -58,7 +57,7 @@ func TestAccAzureRMSqlDatabase_importDataWarehouse(t *testing.T) {
 func TestAccAzureRMSqlDatabase_importElasticPool(t *testing.T) {
 	resourceName := "azurerm_sql_database.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMSqlDatabase_elasticPool(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
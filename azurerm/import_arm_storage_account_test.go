@@ -3,15 +3,14 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMStorageAccount_importBasic(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	config := testAccAzureRMStorageAccount_basic(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -34,8 +33,8 @@ func TestAccAzureRMStorageAccount_importBasic(t *testing.T) {
 func TestAccAzureRMStorageAccount_importPremium(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	config := testAccAzureRMStorageAccount_premium(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -59,8 +58,8 @@ func TestAccAzureRMStorageAccount_importPremium(t *testing.T) {
 func TestAccAzureRMStorageAccount_importNonStandardCasing(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	config := testAccAzureRMStorageAccount_nonStandardCasing(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -84,8 +83,8 @@ func TestAccAzureRMStorageAccount_importNonStandardCasing(t *testing.T) {
 func TestAccAzureRMStorageAccount_importBlobEncryption(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	config := testAccAzureRMStorageAccount_blobEncryption(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -109,8 +108,8 @@ func TestAccAzureRMStorageAccount_importBlobEncryption(t *testing.T) {
 func TestAccAzureRMStorageAccount_importFileEncryption(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	config := testAccAzureRMStorageAccount_fileEncryption(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -134,8 +133,8 @@ func TestAccAzureRMStorageAccount_importFileEncryption(t *testing.T) {
 func TestAccAzureRMStorageAccount_importEnableHttpsTrafficOnly(t *testing.T) {
 	resourceName := "azurerm_storage_account.testsa"
 
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	config := testAccAzureRMStorageAccount_enableHttpsTrafficOnly(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -3,14 +3,13 @@ package azurerm
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMVirtualNetworkGateway_importBasic(t *testing.T) {
 	resourceName := "azurerm_virtual_network_gateway.test"
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMVirtualNetworkGateway_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -5,6 +5,8 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func parseKeyVaultChildID(id string) (*KeyVaultChildID, error) {
@@ -55,3 +57,22 @@ func validateKeyVaultChildName(v interface{}, k string) (ws []string, es []error
 
 	return
 }
+
+// keyVaultChildImporter returns an Importer which validates the ID is the ID of a Key Vault child of the specified
+// type (`certificates`, `keys` or `secrets`), e.g. `https://example.vault.azure.net/secrets/example/{version}`
+func keyVaultChildImporter(childType string) *schema.ResourceImporter {
+	return validatingImporter(func(id string) error {
+		expected := fmt.Sprintf("https://{vaultName}.vault.azure.net/%s/{name}/{version}", childType)
+		if _, err := parseKeyVaultChildID(id); err != nil {
+			return fmt.Errorf("Expected %q to be an ID in the format %q: %+v", id, expected, err)
+		}
+
+		// parseKeyVaultChildID has already validated the URI
+		idURL, _ := url.ParseRequestURI(id)
+		if components := strings.Split(strings.Trim(idURL.Path, "/"), "/"); components[0] != childType {
+			return fmt.Errorf("Expected %q to be an ID in the format %q but got %q rather than %q", id, expected, components[0], childType)
+		}
+
+		return nil
+	})
+}
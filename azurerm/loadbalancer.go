@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"regexp"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
@@ -14,19 +13,18 @@ import (
 )
 
 func resourceGroupAndLBNameFromId(loadBalancerId string) (string, string, error) {
-	id, err := parseAzureResourceID(loadBalancerId)
+	id, err := parseLoadBalancerID(loadBalancerId)
 	if err != nil {
 		return "", "", err
 	}
-	name := id.Path["loadBalancers"]
+	name := id.Name
 	resGroup := id.ResourceGroup
 
 	return resGroup, name, nil
 }
 
-func retrieveLoadBalancerById(loadBalancerId string, meta interface{}) (*network.LoadBalancer, bool, error) {
-	client := meta.(*ArmClient).loadBalancerClient
-	ctx := meta.(*ArmClient).StopContext
+func retrieveLoadBalancerById(ctx context.Context, loadBalancerId string, meta interface{}) (*network.LoadBalancer, bool, error) {
+	client := meta.(*ArmClient).networking().loadBalancerClient
 
 	resGroup, name, err := resourceGroupAndLBNameFromId(loadBalancerId)
 	if err != nil {
@@ -147,23 +145,23 @@ func validateLoadBalancerPrivateIpAddressAllocation(v interface{}, k string) (ws
 	return
 }
 
-// sets the loadbalancer_id in the ResourceData from the sub resources full id
-func loadBalancerSubResourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	r, err := regexp.Compile(`.+\/loadBalancers\/.+?\/`)
-	if err != nil {
-		return nil, err
-	}
-
-	lbID := strings.TrimSuffix(r.FindString(d.Id()), "/")
-	parsed, err := parseAzureResourceID(lbID)
-	if err != nil {
-		return nil, fmt.Errorf("unable to parse loadbalancer id from %s", d.Id())
+// loadBalancerSubResourceImporter returns an Importer for a resource nested within a Load Balancer (for example
+// a Probe) which validates the ID is of the specified type, and sets the loadbalancer_id in the ResourceData from it
+func loadBalancerSubResourceImporter(format resourceIDFormat) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			id, err := format.parse(d.Id())
+			if err != nil {
+				return nil, fmt.Errorf("Error importing %q: %+v", d.Id(), err)
+			}
+
+			loadBalancerID := LoadBalancerID{
+				SubscriptionID: id.subscriptionId,
+				ResourceGroup:  id.resourceGroup,
+				Name:           id.names[0],
+			}
+			d.Set("loadbalancer_id", loadBalancerID.ID())
+			return []*schema.ResourceData{d}, nil
+		},
 	}
-
-	if parsed.Path["loadBalancers"] == "" {
-		return nil, fmt.Errorf("parsed ID is invalid")
-	}
-
-	d.Set("loadbalancer_id", lbID)
-	return []*schema.ResourceData{d}, nil
 }
@@ -1,11 +1,19 @@
 package azurerm
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
+// maxLocationSuggestions is the maximum number of similarly named locations suggested for an unknown location
+const maxLocationSuggestions = 3
+
 func locationSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:             schema.TypeString,
@@ -46,3 +54,154 @@ func azureRMNormalizeLocation(location interface{}) string {
 func azureRMSuppressLocationDiff(k, old, new string, d *schema.ResourceData) bool {
 	return azureRMNormalizeLocation(old) == azureRMNormalizeLocation(new)
 }
+
+// validateLocation validates the `location` of the resource against the locations available to the Subscription,
+// such that a typo (or a region the Subscription can't use) is caught when planning - rather than part-way
+// through an apply.
+func (p *armProvider) validateLocation(info *terraform.InstanceInfo, c *terraform.ResourceConfig) error {
+	resource, ok := p.ResourcesMap[info.Type]
+	if !ok || c == nil {
+		return nil
+	}
+
+	field, ok := resource.Schema["location"]
+	if !ok || field.Deprecated != "" || (!field.Required && !field.Optional) {
+		return nil
+	}
+
+	// the location may not be known until another resource has been created
+	if c.IsComputed("location") {
+		return nil
+	}
+
+	raw, ok := c.Get("location")
+	if !ok {
+		return nil
+	}
+	location, ok := raw.(string)
+	if !ok || location == "" {
+		return nil
+	}
+
+	client, ok := p.Meta().(*ArmClient)
+	if !ok {
+		return nil
+	}
+
+	locations, err := client.availableLocations(client.StopContext)
+	if err != nil {
+		// e.g. the credentials don't have permission to list the locations - in which case the location is
+		// validated by Azure when the resource is created
+		log.Printf("[WARN] Unable to validate the location %q for %s: %+v", location, info.Id, err)
+		return nil
+	}
+
+	if _, ok := locations[azureRMNormalizeLocation(location)]; ok {
+		return nil
+	}
+
+	suggestions := suggestLocations(location, locations)
+	if len(suggestions) == 0 {
+		return fmt.Errorf("%s: the location %q isn't available for Subscription %q", info.Id, location, client.subscriptionId)
+	}
+
+	quoted := make([]string, 0)
+	for _, suggestion := range suggestions {
+		quoted = append(quoted, fmt.Sprintf("%q", suggestion))
+	}
+
+	return fmt.Errorf("%s: the location %q isn't available for Subscription %q - did you mean %s?", info.Id, location, client.subscriptionId, strings.Join(quoted, " or "))
+}
+
+// availableLocations returns the (normalised) names of the locations available to the Subscription, which are
+// only retrieved once.
+func (c *ArmClient) availableLocations(ctx context.Context) (map[string]struct{}, error) {
+	c.locationsOnce.Do(func() {
+		client := c.resources().subscriptionsClient
+		result, err := client.ListLocations(ctx, c.subscriptionId)
+		if err != nil {
+			c.locationsErr = fmt.Errorf("Error listing the locations available to Subscription %q: %+v", c.subscriptionId, err)
+			return
+		}
+
+		c.locations = make(map[string]struct{})
+		if result.Value != nil {
+			for _, location := range *result.Value {
+				if location.Name != nil {
+					c.locations[azureRMNormalizeLocation(*location.Name)] = struct{}{}
+				}
+			}
+		}
+	})
+
+	return c.locations, c.locationsErr
+}
+
+// suggestLocations returns the locations whose names are closest to the specified location, ordered by how
+// similar they are - or none if no location is similar enough to be a plausible typo.
+func suggestLocations(input string, locations map[string]struct{}) []string {
+	normalised := azureRMNormalizeLocation(input)
+
+	// allow for roughly one typo per word
+	maxDistance := len(normalised) / 4
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	distances := make(map[string]int)
+	candidates := make([]string, 0)
+	for location := range locations {
+		distance := levenshteinDistance(normalised, location)
+		if distance <= maxDistance {
+			distances[location] = distance
+			candidates = append(candidates, location)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if distances[candidates[i]] != distances[candidates[j]] {
+			return distances[candidates[i]] < distances[candidates[j]]
+		}
+		return candidates[i] < candidates[j]
+	})
+
+	if len(candidates) > maxLocationSuggestions {
+		candidates = candidates[:maxLocationSuggestions]
+	}
+	return candidates
+}
+
+// levenshteinDistance returns the number of single character insertions, deletions and substitutions
+// needed to turn a into b
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	min := values[0]
+	for _, v := range values[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return min
+}
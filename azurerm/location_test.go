@@ -1,6 +1,9 @@
 package azurerm
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestAzureRMNormalizeLocation(t *testing.T) {
 	s := azureRMNormalizeLocation("West US")
@@ -8,3 +11,62 @@ func TestAzureRMNormalizeLocation(t *testing.T) {
 		t.Fatalf("expected location to equal westus, actual %s", s)
 	}
 }
+
+func TestSuggestLocations(t *testing.T) {
+	locations := map[string]struct{}{
+		"eastus":      {},
+		"eastus2":     {},
+		"northeurope": {},
+		"westeurope":  {},
+		"westus":      {},
+		"westus2":     {},
+	}
+
+	cases := []struct {
+		Input    string
+		Expected []string
+	}{
+		{
+			Input:    "westeurpoe",
+			Expected: []string{"westeurope"},
+		},
+		{
+			Input:    "West Eurpoe",
+			Expected: []string{"westeurope"},
+		},
+		{
+			Input:    "westus3",
+			Expected: []string{"westus", "westus2"},
+		},
+		{
+			Input:    "australiacentral",
+			Expected: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		suggestions := suggestLocations(tc.Input, locations)
+		if !reflect.DeepEqual(suggestions, tc.Expected) {
+			t.Fatalf("Expected the suggestions for %q to be %+v but got %+v", tc.Input, tc.Expected, suggestions)
+		}
+	}
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	cases := []struct {
+		A        string
+		B        string
+		Expected int
+	}{
+		{A: "westeurope", B: "westeurope", Expected: 0},
+		{A: "westeurpoe", B: "westeurope", Expected: 2},
+		{A: "westus", B: "westus2", Expected: 1},
+		{A: "", B: "uksouth", Expected: 7},
+	}
+
+	for _, tc := range cases {
+		if distance := levenshteinDistance(tc.A, tc.B); distance != tc.Expected {
+			t.Fatalf("Expected the distance between %q and %q to be %d but got %d", tc.A, tc.B, tc.Expected, distance)
+		}
+	}
+}
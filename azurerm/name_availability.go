@@ -0,0 +1,189 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2017-10-01/containerregistry"
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-04-30-preview/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-04-30-preview/postgresql"
+	"github.com/Azure/azure-sdk-for-go/services/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2016-09-01/web"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// nameAvailabilityFunc checks whether the name is available, returning the reason given by Azure when it isn't
+type nameAvailabilityFunc func(ctx context.Context, client *ArmClient, name string) (available bool, reason string, err error)
+
+// globallyUniqueNameResources are the resources whose names are used as a globally unique DNS label (for example
+// `{name}.database.windows.net`), along with a function which checks whether the name is available.
+//
+// Key Vaults aren't included, since the version of the Key Vault SDK in use doesn't expose the API to check this.
+var globallyUniqueNameResources = map[string]nameAvailabilityFunc{
+	"azurerm_app_service":        checkAppServiceNameAvailability,
+	"azurerm_container_registry": checkContainerRegistryNameAvailability,
+	"azurerm_cosmosdb_account":   checkCosmosDBAccountNameAvailability,
+	"azurerm_function_app":       checkAppServiceNameAvailability,
+	"azurerm_mysql_server":       checkMySQLServerNameAvailability,
+	"azurerm_postgresql_server":  checkPostgreSQLServerNameAvailability,
+	"azurerm_sql_server":         checkSqlServerNameAvailability,
+	"azurerm_storage_account":    checkStorageAccountNameAvailability,
+}
+
+// validateNameAvailability checks that the name of a resource which is being created is available, for those
+// resources whose names need to be globally unique - such that a name which is already taken is reported
+// when planning, rather than part-way through an apply.
+func (p *armProvider) validateNameAvailability(info *terraform.InstanceInfo, s *terraform.InstanceState, d *terraform.InstanceDiff) error {
+	check, ok := globallyUniqueNameResources[info.Type]
+	if !ok || d == nil || d.Empty() || d.GetDestroy() {
+		return nil
+	}
+
+	// only resources which are being created (or replaced with a different name) need a name which is available
+	if s != nil && s.ID != "" && !d.RequiresNew() {
+		return nil
+	}
+
+	attribute, ok := d.Attributes["name"]
+	if !ok || attribute.NewComputed || attribute.New == "" {
+		return nil
+	}
+	name := attribute.New
+
+	// when a resource is replaced using the same name, the name is in use by the resource being replaced - which is
+	// deleted before the replacement is created
+	if s != nil && s.ID != "" && strings.EqualFold(s.Attributes["name"], name) {
+		return nil
+	}
+
+	client, ok := p.Meta().(*ArmClient)
+	if !ok {
+		return nil
+	}
+
+	available, reason, err := check(client.StopContext, client, name)
+	if err != nil {
+		log.Printf("[WARN] Unable to check whether the name %q is available for %s: %+v", name, info.Id, err)
+		return nil
+	}
+
+	if !available {
+		return fmt.Errorf("%s: the name %q isn't available: %s", info.Id, name, reason)
+	}
+
+	return nil
+}
+
+// nameUnavailableReason returns the message Azure gave for a name being unavailable, falling back to the reason code
+func nameUnavailableReason(reason string, message *string) string {
+	if message != nil && *message != "" {
+		return *message
+	}
+
+	return reason
+}
+
+func checkAppServiceNameAvailability(ctx context.Context, client *ArmClient, name string) (bool, string, error) {
+	resp, err := client.web().webClient.CheckNameAvailability(ctx, web.ResourceNameAvailabilityRequest{
+		Name: utils.String(name),
+		Type: web.CheckNameResourceTypesSite,
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.NameAvailable == nil || *resp.NameAvailable, nameUnavailableReason(string(resp.Reason), resp.Message), nil
+}
+
+func checkContainerRegistryNameAvailability(ctx context.Context, client *ArmClient, name string) (bool, string, error) {
+	resp, err := client.containerRegistry().containerRegistryClient.CheckNameAvailability(ctx, containerregistry.RegistryNameCheckRequest{
+		Name: utils.String(name),
+		Type: utils.String("Microsoft.ContainerRegistry/registries"),
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	reason := ""
+	if resp.Reason != nil {
+		reason = *resp.Reason
+	}
+
+	return resp.NameAvailable == nil || *resp.NameAvailable, nameUnavailableReason(reason, resp.Message), nil
+}
+
+func checkCosmosDBAccountNameAvailability(ctx context.Context, client *ArmClient, name string) (bool, string, error) {
+	resp, err := client.cosmosDB().cosmosDBClient.CheckNameExists(ctx, name)
+	if err != nil {
+		return false, "", err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		return false, "a Cosmos DB Account with this name already exists", nil
+	}
+
+	return true, "", nil
+}
+
+func checkMySQLServerNameAvailability(ctx context.Context, client *ArmClient, name string) (bool, string, error) {
+	resp, err := client.databases().mysqlNameAvailabilityClient.Execute(ctx, mysql.NameAvailabilityRequest{
+		Name: utils.String(name),
+		Type: utils.String("Microsoft.DBforMySQL/servers"),
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	reason := ""
+	if resp.Reason != nil {
+		reason = *resp.Reason
+	}
+
+	return resp.NameAvailable == nil || *resp.NameAvailable, nameUnavailableReason(reason, resp.Message), nil
+}
+
+func checkPostgreSQLServerNameAvailability(ctx context.Context, client *ArmClient, name string) (bool, string, error) {
+	resp, err := client.databases().postgresqlNameAvailabilityClient.Execute(ctx, postgresql.NameAvailabilityRequest{
+		Name: utils.String(name),
+		Type: utils.String("Microsoft.DBforPostgreSQL/servers"),
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	reason := ""
+	if resp.Reason != nil {
+		reason = *resp.Reason
+	}
+
+	return resp.NameAvailable == nil || *resp.NameAvailable, nameUnavailableReason(reason, resp.Message), nil
+}
+
+func checkSqlServerNameAvailability(ctx context.Context, client *ArmClient, name string) (bool, string, error) {
+	resp, err := client.databases().sqlServersClient.CheckNameAvailability(ctx, sql.CheckNameAvailabilityRequest{
+		Name: utils.String(name),
+		Type: utils.String("Microsoft.Sql/servers"),
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.Available == nil || *resp.Available, nameUnavailableReason(string(resp.Reason), resp.Message), nil
+}
+
+func checkStorageAccountNameAvailability(ctx context.Context, client *ArmClient, name string) (bool, string, error) {
+	resp, err := client.storage().storageServiceClient.CheckNameAvailability(storage.AccountCheckNameAvailabilityParameters{
+		Name: utils.String(name),
+		Type: utils.String("Microsoft.Storage/storageAccounts"),
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	return resp.NameAvailable == nil || *resp.NameAvailable, nameUnavailableReason(string(resp.Reason), resp.Message), nil
+}
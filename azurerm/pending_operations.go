@@ -0,0 +1,181 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// pendingOperationKey is the key within the private data of a resource (the `Meta` of its Instance State) which
+// holds the long-running operation that was still in progress when creating the resource was interrupted
+const pendingOperationKey = "azurerm_pending_operation"
+
+// pendingOperationTimeout is how long the operation stored in the State is waited on for, when the State doesn't
+// contain a Read timeout for the resource
+var pendingOperationTimeout = 20 * time.Minute
+
+// Apply stores the long-running operation which was in progress when creating a resource was interrupted (for
+// example the apply was cancelled, or the timeout was reached) in the State - so that it's polled until it
+// completes on the next run, rather than another PUT being issued which either conflicts with the operation
+// which is in progress, or creates a duplicate.
+//
+// helper/schema doesn't expose the private data of a resource, as such this is done at the Provider level.
+func (p *armProvider) Apply(info *terraform.InstanceInfo, s *terraform.InstanceState, d *terraform.InstanceDiff) (*terraform.InstanceState, error) {
+	completed, err := p.resumePendingOperation(info, s)
+	if err != nil {
+		return s, err
+	}
+	if !completed {
+		return s, fmt.Errorf("Error applying %q: the operation which was in progress for it is still in progress and will be resumed on the next run", s.ID)
+	}
+
+	state, err := p.Provider.Apply(info, s, d)
+	if state == nil {
+		return state, err
+	}
+
+	client := p.Meta().(*ArmClient)
+	if future, ok := client.pendingOperations.remove(state.ID); ok {
+		encoded, encodeErr := json.Marshal(future)
+		if encodeErr != nil {
+			log.Printf("[WARN] Error storing the operation which is in progress for %q: %+v", state.ID, encodeErr)
+			return state, err
+		}
+
+		if state.Meta == nil {
+			state.Meta = make(map[string]interface{})
+		}
+		state.Meta[pendingOperationKey] = string(encoded)
+	}
+
+	return state, err
+}
+
+// Refresh leaves the State as-is whilst the operation stored in it is still in progress, since the resource
+// may not exist yet - and reading it would remove it (and the operation) from the State.
+func (p *armProvider) Refresh(info *terraform.InstanceInfo, s *terraform.InstanceState) (*terraform.InstanceState, error) {
+	completed, err := p.resumePendingOperation(info, s)
+	if err != nil {
+		return s, err
+	}
+	if !completed {
+		return s, nil
+	}
+
+	return p.Provider.Refresh(info, s)
+}
+
+// resumePendingOperation polls the long-running operation stored in the State (if any) until it completes, for up
+// to the Read timeout of the resource. It returns false if the operation is still in progress, in which case it's
+// left in the State to be resumed on the next run.
+func (p *armProvider) resumePendingOperation(info *terraform.InstanceInfo, s *terraform.InstanceState) (bool, error) {
+	if s == nil || s.Meta == nil {
+		return true, nil
+	}
+
+	encoded, ok := s.Meta[pendingOperationKey].(string)
+	if !ok {
+		return true, nil
+	}
+
+	var future azure.Future
+	if err := json.Unmarshal([]byte(encoded), &future); err != nil {
+		return false, fmt.Errorf("Error parsing the operation which was in progress for %q: %+v", s.ID, err)
+	}
+
+	log.Printf("[INFO] Resuming the operation which was in progress when %s (%q) was last applied", info.Id, s.ID)
+	client := p.Meta().(*ArmClient)
+	ctx, cancel := context.WithTimeout(client.StopContext, pendingOperationReadTimeout(s))
+	defer cancel()
+
+	if err := future.WaitForCompletion(ctx, client.pollingClient()); err != nil {
+		if client.StopContext.Err() != nil {
+			return false, fmt.Errorf("Error waiting for the operation which was in progress for %q to complete: %+v", s.ID, err)
+		}
+
+		if ctx.Err() != nil {
+			log.Printf("[WARN] The operation which was in progress for %q is still in progress: %+v", s.ID, err)
+			return false, nil
+		}
+
+		// the resource may still have been created (albeit in a failed state) - which the Read will determine
+		log.Printf("[WARN] The operation which was in progress for %q failed: %+v", s.ID, err)
+	}
+
+	delete(s.Meta, pendingOperationKey)
+	return true, nil
+}
+
+// pendingOperationReadTimeout returns the Read timeout of the resource stored in the State, falling back to the
+// Default timeout and then pendingOperationTimeout
+func pendingOperationReadTimeout(s *terraform.InstanceState) time.Duration {
+	if _, ok := s.Meta[schema.TimeoutKey]; !ok {
+		return pendingOperationTimeout
+	}
+
+	timeouts := schema.ResourceTimeout{}
+	if err := timeouts.StateDecode(s); err != nil {
+		log.Printf("[WARN] Error decoding the timeouts for %q: %+v", s.ID, err)
+		return pendingOperationTimeout
+	}
+
+	if timeouts.Read != nil {
+		return *timeouts.Read
+	}
+	if timeouts.Default != nil {
+		return *timeouts.Default
+	}
+
+	return pendingOperationTimeout
+}
+
+// waitForCreation waits for the long-running operation which creates (or updates) a resource to complete. When
+// creating a resource is interrupted (e.g. the apply is cancelled, or the timeout is reached) the operation is
+// recorded against the ID of the resource, so that it can be resumed on the next run.
+func waitForCreation(ctx context.Context, d *schema.ResourceData, meta interface{}, future azure.Future, client autorest.Client) error {
+	err := future.WaitForCompletion(ctx, client)
+	if err == nil || ctx.Err() == nil || !d.IsNewResource() || future.Response() == nil || future.Response().Request == nil {
+		return err
+	}
+
+	// the ID of the resource is the path of the request which created it
+	id := future.Response().Request.URL.Path
+	meta.(*ArmClient).pendingOperations.add(id, future)
+	d.SetId(id)
+
+	return fmt.Errorf("Error waiting for %q to be created - the operation is still in progress and will be resumed on the next run: %+v", id, err)
+}
+
+// pendingOperations are the long-running operations which were interrupted, keyed by the ID of the resource
+type pendingOperations struct {
+	lock       sync.Mutex
+	operations map[string]azure.Future
+}
+
+func (o *pendingOperations) add(id string, future azure.Future) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if o.operations == nil {
+		o.operations = make(map[string]azure.Future)
+	}
+	o.operations[strings.ToLower(id)] = future
+}
+
+func (o *pendingOperations) remove(id string) (azure.Future, bool) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	future, ok := o.operations[strings.ToLower(id)]
+	delete(o.operations, strings.ToLower(id))
+	return future, ok
+}
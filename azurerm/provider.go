@@ -1,18 +1,19 @@
 package azurerm
 
 import (
-	"context"
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"log"
+	"os"
 	"strings"
-	"sync"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
 )
@@ -40,18 +41,120 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_SECRET", ""),
 			},
 
+			"client_certificate_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PATH", ""),
+			},
+
+			"client_certificate_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PASSWORD", ""),
+			},
+
 			"tenant_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_TENANT_ID", ""),
 			},
 
+			"auxiliary_tenant_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"environment": {
 				Type:        schema.TypeString,
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_ENVIRONMENT", "public"),
 			},
 
+			"arm_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ENDPOINT", ""),
+			},
+
+			"use_msi": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_MSI", false),
+			},
+
+			"msi_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_MSI_ENDPOINT", ""),
+			},
+
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", defaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_retry_delay_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRY_DELAY_IN_SECONDS", int(defaultMaxRetryDelay.Seconds())),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"disable_http_body_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_DISABLE_HTTP_BODY_LOGGING", false),
+			},
+
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: validateAzureRMTags,
+						},
+					},
+				},
+			},
+
+			"ignore_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 512),
+							},
+						},
+
+						"key_prefixes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 512),
+							},
+						},
+					},
+				},
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -63,6 +166,27 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"adopt_existing_resources": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ADOPT_EXISTING_RESOURCES", false),
+			},
+
+			"prevent_resource_group_deletion_if_contains_resources": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_PREVENT_RESOURCE_GROUP_DELETION_IF_CONTAINS_RESOURCES", false),
+			},
+
+			"resource_providers_to_register": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -78,6 +202,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_platform_image":          dataSourceArmPlatformImage(),
 			"azurerm_public_ip":               dataSourceArmPublicIP(),
 			"azurerm_resource_group":          dataSourceArmResourceGroup(),
+			"azurerm_resources":               dataSourceArmResources(),
 			"azurerm_role_definition":         dataSourceArmRoleDefinition(),
 			"azurerm_storage_account":         dataSourceArmStorageAccount(),
 			"azurerm_snapshot":                dataSourceArmSnapshot(),
@@ -153,6 +278,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_public_ip":                           resourceArmPublicIp(),
 			"azurerm_redis_cache":                         resourceArmRedisCache(),
 			"azurerm_redis_firewall_rule":                 resourceArmRedisFirewallRule(),
+			"azurerm_resource":                            resourceArmResource(),
 			"azurerm_resource_group":                      resourceArmResourceGroup(),
 			"azurerm_role_assignment":                     resourceArmRoleAssignment(),
 			"azurerm_role_definition":                     resourceArmRoleDefinition(),
@@ -191,7 +317,38 @@ func Provider() terraform.ResourceProvider {
 
 	p.ConfigureFunc = providerConfigure(p)
 
-	return p
+	return &armProvider{Provider: p}
+}
+
+// armProvider wraps the Provider for the functionality helper/schema doesn't support at the resource level,
+// such as storing private data in the State and validating the configuration against the Subscription at
+// plan time.
+type armProvider struct {
+	*schema.Provider
+}
+
+// Diff merges the `default_tags` into the configuration (see configWithDefaultTags) and validates the configuration
+// against what's available to the Subscription (see validateLocation, validateComputeCapacity and
+// validateNameAvailability), since helper/schema (at this version) doesn't support customising the diff.
+func (p *armProvider) Diff(info *terraform.InstanceInfo, s *terraform.InstanceState, c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+	if err := p.validateLocation(info, c); err != nil {
+		return nil, err
+	}
+
+	diff, err := p.Provider.Diff(info, s, p.configWithDefaultTags(info, s, c))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.validateComputeCapacity(info, s, diff); err != nil {
+		return nil, err
+	}
+
+	if err := p.validateNameAvailability(info, s, diff); err != nil {
+		return nil, err
+	}
+
+	return diff, nil
 }
 
 func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
@@ -200,13 +357,50 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			SubscriptionID:            d.Get("subscription_id").(string),
 			ClientID:                  d.Get("client_id").(string),
 			ClientSecret:              d.Get("client_secret").(string),
+			ClientCertPath:            d.Get("client_certificate_path").(string),
+			ClientCertPassword:        d.Get("client_certificate_password").(string),
 			TenantID:                  d.Get("tenant_id").(string),
 			Environment:               d.Get("environment").(string),
+			ArmEndpoint:               d.Get("arm_endpoint").(string),
+			UseMsi:                    d.Get("use_msi").(bool),
+			MsiEndpoint:               d.Get("msi_endpoint").(string),
 			SkipCredentialsValidation: d.Get("skip_credentials_validation").(bool),
 			SkipProviderRegistration:  d.Get("skip_provider_registration").(bool),
+			MaxRetries:                d.Get("max_retries").(int),
+			MaxRetryDelay:             time.Duration(d.Get("max_retry_delay_in_seconds").(int)) * time.Second,
+			DisableHttpBodyLogging:    d.Get("disable_http_body_logging").(bool),
+		}
+
+		for _, v := range d.Get("auxiliary_tenant_ids").([]interface{}) {
+			config.AuxiliaryTenantIDs = append(config.AuxiliaryTenantIDs, v.(string))
+		}
+		if len(config.AuxiliaryTenantIDs) == 0 {
+			if v := os.Getenv("ARM_AUXILIARY_TENANT_IDS"); v != "" {
+				config.AuxiliaryTenantIDs = strings.Split(v, ";")
+			}
 		}
 
-		if config.ClientSecret != "" {
+		if config.UseMsi {
+			log.Printf("[DEBUG] use_msi specified - using Managed Service Identity for Authentication")
+			if config.MsiEndpoint == "" {
+				msiEndpoint, err := adal.GetMSIVMEndpoint()
+				if err != nil {
+					return nil, fmt.Errorf("Could not retrieve the MSI endpoint from the VM settings. "+
+						"Ensure the VM has MSI enabled, or specify the `msi_endpoint`: %+v", err)
+				}
+				config.MsiEndpoint = msiEndpoint
+			}
+
+			log.Printf("[DEBUG] Using MSI endpoint %q", config.MsiEndpoint)
+			if err := config.ValidateMsi(); err != nil {
+				return nil, err
+			}
+		} else if config.ClientCertPath != "" {
+			log.Printf("[DEBUG] Client Certificate specified - using Service Principal (Client Certificate) for Authentication")
+			if err := config.ValidateServicePrincipalCertificate(); err != nil {
+				return nil, err
+			}
+		} else if config.ClientSecret != "" {
 			log.Printf("[DEBUG] Client Secret specified - using Service Principal for Authentication")
 			if err := config.ValidateServicePrincipal(); err != nil {
 				return nil, err
@@ -222,12 +416,20 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			}
 		}
 
+		if err := config.ValidateAuxiliaryTenants(); err != nil {
+			return nil, err
+		}
+
 		client, err := getArmClient(config)
 		if err != nil {
 			return nil, err
 		}
 
 		client.StopContext = p.StopContext()
+		client.defaultTags = expandProviderDefaultTags(d.Get("default_tags").([]interface{}))
+		client.ignoreTags = expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{}))
+		client.adoptExistingResources = d.Get("adopt_existing_resources").(bool)
+		client.preventResourceGroupDeletionIfContainsResources = d.Get("prevent_resource_group_deletion_if_contains_resources").(bool)
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
@@ -239,99 +441,31 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			// List all the available providers and their registration state to avoid unnecessary
 			// requests. This also lets us check if the provider credentials are correct.
 			ctx := client.StopContext
-			providerList, err := client.providersClient.List(ctx, nil, "")
+			providerList, err := client.resources().providersClient.List(ctx, nil, "")
 			if err != nil {
 				return nil, fmt.Errorf("Unable to list provider registration status, it is possible that this is due to invalid "+
 					"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
 					"error: %s", err)
 			}
 
-			if !config.SkipProviderRegistration {
-				err = registerAzureResourceProvidersWithSubscription(ctx, providerList.Values(), client.providersClient)
-				if err != nil {
-					return nil, err
-				}
-			}
+			client.resourceProviders.setRegistrationStates(providerList.Values())
 		}
 
-		return client, nil
-	}
-}
-
-func registerProviderWithSubscription(ctx context.Context, providerName string, client resources.ProvidersClient) error {
-	_, err := client.Register(ctx, providerName)
-	if err != nil {
-		return fmt.Errorf("Cannot register provider %s with Azure Resource Manager: %s.", providerName, err)
-	}
-
-	return nil
-}
-
-func determineAzureResourceProvidersToRegister(providerList []resources.Provider) map[string]struct{} {
-	providers := map[string]struct{}{
-		"Microsoft.Authorization":       {},
-		"Microsoft.Automation":          {},
-		"Microsoft.Cache":               {},
-		"Microsoft.Cdn":                 {},
-		"Microsoft.Compute":             {},
-		"Microsoft.ContainerInstance":   {},
-		"Microsoft.ContainerRegistry":   {},
-		"Microsoft.ContainerService":    {},
-		"Microsoft.DBforMySQL":          {},
-		"Microsoft.DBforPostgreSQL":     {},
-		"Microsoft.DocumentDB":          {},
-		"Microsoft.EventGrid":           {},
-		"Microsoft.EventHub":            {},
-		"Microsoft.KeyVault":            {},
-		"microsoft.insights":            {},
-		"Microsoft.Network":             {},
-		"Microsoft.OperationalInsights": {},
-		"Microsoft.Resources":           {},
-		"Microsoft.Search":              {},
-		"Microsoft.ServiceBus":          {},
-		"Microsoft.Sql":                 {},
-		"Microsoft.Storage":             {},
-	}
-
-	// filter out any providers already registered
-	for _, p := range providerList {
-		if _, ok := providers[*p.Namespace]; !ok {
-			continue
-		}
+		// the Resource Providers used by the configuration are registered when they're first used, however
+		// those which are used indirectly (e.g. by a Template Deployment) can be registered up-front
+		if !config.SkipProviderRegistration && !config.SkipCredentialsValidation {
+			namespaces := make([]string, 0)
+			for _, v := range d.Get("resource_providers_to_register").([]interface{}) {
+				namespaces = append(namespaces, v.(string))
+			}
 
-		if strings.ToLower(*p.RegistrationState) == "registered" {
-			log.Printf("[DEBUG] Skipping provider registration for namespace %s\n", *p.Namespace)
-			delete(providers, *p.Namespace)
+			if err := client.resourceProviders.ensureRegisteredAll(client.StopContext, namespaces); err != nil {
+				return nil, fmt.Errorf("Error registering Resource Providers: %+v", err)
+			}
 		}
-	}
 
-	return providers
-}
-
-// registerAzureResourceProvidersWithSubscription uses the providers client to register
-// all Azure resource providers which the Terraform provider may require (regardless of
-// whether they are actually used by the configuration or not). It was confirmed by Microsoft
-// that this is the approach their own internal tools also take.
-func registerAzureResourceProvidersWithSubscription(ctx context.Context, providerList []resources.Provider, client resources.ProvidersClient) error {
-	providers := determineAzureResourceProvidersToRegister(providerList)
-
-	var err error
-	var wg sync.WaitGroup
-	wg.Add(len(providers))
-
-	for providerName := range providers {
-		go func(p string) {
-			defer wg.Done()
-			log.Printf("[DEBUG] Registering provider with namespace %s\n", p)
-			if innerErr := registerProviderWithSubscription(ctx, p, client); err != nil {
-				err = innerErr
-			}
-		}(providerName)
+		return client, nil
 	}
-
-	wg.Wait()
-
-	return err
 }
 
 // armMutexKV is the instance of MutexKV for ARM resources
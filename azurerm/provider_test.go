@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
@@ -17,14 +16,15 @@ var testAccProviders map[string]terraform.ResourceProvider
 var testAccProvider *schema.Provider
 
 func init() {
-	testAccProvider = Provider().(*schema.Provider)
+	provider := Provider().(*armProvider)
+	testAccProvider = provider.Provider
 	testAccProviders = map[string]terraform.ResourceProvider{
-		"azurerm": testAccProvider,
+		"azurerm": provider,
 	}
 }
 
 func TestProvider(t *testing.T) {
-	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
+	if err := Provider().(*armProvider).InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
@@ -34,6 +34,9 @@ func TestProvider_impl(t *testing.T) {
 }
 
 func testAccPreCheck(t *testing.T) {
+	// requests are recorded to (or replayed from) the test's cassette when `ARM_TEST_RECORDING_MODE` is set
+	testAccCassette(t)
+
 	variables := []string{
 		"ARM_SUBSCRIPTION_ID",
 		"ARM_CLIENT_ID",
@@ -91,6 +94,7 @@ func testGetAzureConfig(t *testing.T) *authentication.Config {
 		return nil
 	}
 
+	testAccCassette(t)
 	environment := testArmEnvironmentName()
 
 	// we deliberately don't use the main config - since we care about
@@ -116,22 +120,21 @@ func TestAccAzureRMResourceProviderRegistration(t *testing.T) {
 		t.Fatalf("Error building ARM Client: %+v", err)
 	}
 
-	client := armClient.providersClient
+	namespaces := []string{"Microsoft.Compute", "Microsoft.Network", "Microsoft.Storage"}
 	ctx := testAccProvider.StopContext()
-	providerList, err := client.List(ctx, nil, "")
-	if err != nil {
-		t.Fatalf("Unable to list provider registration status, it is possible that this is due to invalid "+
-			"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
-			"error: %s", err)
-	}
-
-	err = registerAzureResourceProvidersWithSubscription(ctx, providerList.Values(), client)
-	if err != nil {
+	if err := armClient.resourceProviders.ensureRegisteredAll(ctx, namespaces); err != nil {
 		t.Fatalf("Error registering Resource Providers: %+v", err)
 	}
 
-	needingRegistration := determineAzureResourceProvidersToRegister(providerList.Values())
-	if len(needingRegistration) > 0 {
-		t.Fatalf("'%d' Resource Providers are still Pending Registration: %s", len(needingRegistration), spew.Sprint(needingRegistration))
+	client := armClient.resources().providersClient
+	for _, namespace := range namespaces {
+		provider, err := client.Get(ctx, namespace, "")
+		if err != nil {
+			t.Fatalf("Error retrieving the Resource Provider %q: %+v", namespace, err)
+		}
+
+		if *provider.RegistrationState != "Registered" {
+			t.Fatalf("Expected the Resource Provider %q to be Registered but got %q", namespace, *provider.RegistrationState)
+		}
 	}
 }
@@ -0,0 +1,315 @@
+package azurerm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// recordingModeEnvVar configures whether the acceptance tests record their requests to a cassette,
+	// or replay them from one - otherwise requests are made to Azure as usual
+	recordingModeEnvVar = "ARM_TEST_RECORDING_MODE"
+	recordingModeRecord = "record"
+	recordingModeReplay = "replay"
+
+	// the Resource Manager endpoint, Subscription and Tenant IDs are replaced with these placeholders within
+	// cassettes - such that a cassette can be replayed against a different endpoint (or Subscription)
+	recordingEndpointPlaceholder       = "https://management.azure.com/"
+	recordingSubscriptionIdPlaceholder = "00000000-0000-0000-0000-000000000000"
+	recordingTenantIdPlaceholder       = "11111111-1111-1111-1111-111111111111"
+)
+
+// cassette contains the requests (and their responses) made during an acceptance test, along with the
+// random values used in the test - such that the test can be replayed offline.
+type cassette struct {
+	Variables    []string               `json:"variables"`
+	Interactions []*cassetteInteraction `json:"interactions"`
+
+	path string
+	mode string
+	lock sync.Mutex
+
+	// used when replaying, to track which variables and interactions have been used
+	variablesUsed int
+	used          map[int]struct{}
+}
+
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+type cassetteRequest struct {
+	Method string `json:"method"`
+	Url    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type cassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+var (
+	activeCassetteLock sync.Mutex
+	activeCassette     *cassette
+)
+
+// currentCassette returns the cassette of the test which is running, which requests made by the clients the
+// Provider configures are recorded to (or replayed from) - if any.
+func currentCassette() *cassette {
+	activeCassetteLock.Lock()
+	defer activeCassetteLock.Unlock()
+
+	return activeCassette
+}
+
+func setCurrentCassette(c *cassette) {
+	activeCassetteLock.Lock()
+	defer activeCassetteLock.Unlock()
+
+	activeCassette = c
+}
+
+// loadCassette loads the cassette at the specified path when replaying - or returns an empty cassette
+// which is written to the path when recording.
+func loadCassette(path string, mode string) (*cassette, error) {
+	c := &cassette{
+		Variables:    make([]string, 0),
+		Interactions: make([]*cassetteInteraction, 0),
+		path:         path,
+		mode:         mode,
+		used:         make(map[int]struct{}),
+	}
+
+	if mode == recordingModeRecord {
+		return c, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the cassette %q: %+v", path, err)
+	}
+
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("Error parsing the cassette %q: %+v", path, err)
+	}
+
+	return c, nil
+}
+
+func (c *cassette) replaying() bool {
+	return c.mode == recordingModeReplay
+}
+
+// save writes the cassette to disk, once the test has finished recording it.
+func (c *cassette) save() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(c.path, data, 0644)
+}
+
+// variable returns the value of the next variable (e.g. the random number used in resource names). When
+// recording the generated value is stored in the cassette, and when replaying the stored value is returned.
+func (c *cassette) variable(generate func() string) (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.replaying() {
+		if c.variablesUsed >= len(c.Variables) {
+			return "", fmt.Errorf("The cassette %q doesn't contain enough variables - it needs to be recorded again", c.path)
+		}
+
+		value := c.Variables[c.variablesUsed]
+		c.variablesUsed++
+		return value, nil
+	}
+
+	value := generate()
+	c.Variables = append(c.Variables, value)
+	return value, nil
+}
+
+// recordingScrubber replaces the Resource Manager endpoint, Subscription and Tenant IDs with placeholders in
+// recorded interactions, and restores the current ones in replayed interactions.
+type recordingScrubber struct {
+	scrubber *strings.Replacer
+	restorer *strings.Replacer
+}
+
+func newRecordingScrubber(endpoint, subscriptionId, tenantId string) recordingScrubber {
+	scrub := make([]string, 0)
+	restore := make([]string, 0)
+	if endpoint != "" && endpoint != recordingEndpointPlaceholder {
+		endpoint = strings.TrimSuffix(endpoint, "/") + "/"
+		scrub = append(scrub, endpoint, recordingEndpointPlaceholder)
+		restore = append(restore, recordingEndpointPlaceholder, endpoint)
+	}
+	if subscriptionId != "" {
+		scrub = append(scrub, subscriptionId, recordingSubscriptionIdPlaceholder)
+		restore = append(restore, recordingSubscriptionIdPlaceholder, subscriptionId)
+	}
+	if tenantId != "" {
+		scrub = append(scrub, tenantId, recordingTenantIdPlaceholder)
+		restore = append(restore, recordingTenantIdPlaceholder, tenantId)
+	}
+
+	return recordingScrubber{
+		scrubber: strings.NewReplacer(scrub...),
+		restorer: strings.NewReplacer(restore...),
+	}
+}
+
+// withRecording returns a SendDecorator which records each request and response to the cassette - or
+// when replaying, returns the recorded response rather than sending the request.
+func withRecording(c *cassette, endpoint, subscriptionId, tenantId string) autorest.SendDecorator {
+	scrubber := newRecordingScrubber(endpoint, subscriptionId, tenantId)
+
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if c.replaying() {
+				return c.replay(r, scrubber)
+			}
+
+			var body []byte
+			if r.Body != nil {
+				var err error
+				body, err = ioutil.ReadAll(r.Body)
+				r.Body.Close()
+				if err != nil {
+					return nil, err
+				}
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			}
+
+			resp, err := s.Do(r)
+			if err != nil || resp == nil {
+				return resp, err
+			}
+
+			if err := c.record(r, body, resp, scrubber); err != nil {
+				log.Printf("[DEBUG] Error recording the response for %s to %q: %+v", redactUrl(r.URL), c.path, err)
+			}
+
+			return resp, nil
+		})
+	}
+}
+
+// record stores the request and response in the cassette, with any secrets redacted.
+func (c *cassette) record(r *http.Request, requestBody []byte, resp *http.Response, scrubber recordingScrubber) error {
+	var responseBody []byte
+	if resp.Body != nil {
+		var err error
+		responseBody, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+	}
+
+	headers := make(http.Header)
+	for key, values := range redactHeaders(resp.Header) {
+		for _, value := range values {
+			headers.Add(key, scrubber.scrubber.Replace(value))
+		}
+	}
+
+	// the responses of sensitive operations (e.g. listing the keys for a Storage Account) consist entirely of
+	// secrets, however the structure needs to be retained so that they can be parsed when replayed
+	redactResponseValues := isKeyVaultSecretRequest(r) || isSensitiveOperation(r)
+
+	interaction := &cassetteInteraction{
+		Request: cassetteRequest{
+			Method: r.Method,
+			Url:    recordingUrl(r, scrubber),
+			Body:   scrubber.scrubber.Replace(string(redactJsonBody(requestBody, isKeyVaultSecretRequest(r)))),
+		},
+		Response: cassetteResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    headers,
+			Body:       scrubber.scrubber.Replace(string(redactJsonBody(responseBody, redactResponseValues))),
+		},
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.Interactions = append(c.Interactions, interaction)
+	return nil
+}
+
+// replay returns the response for the first unused interaction with the same method and URL as the
+// request. Terraform makes requests for independent resources in parallel, as such the interactions
+// for each URL are replayed in the order they were recorded, rather than the cassette as a whole.
+func (c *cassette) replay(r *http.Request, scrubber recordingScrubber) (*http.Response, error) {
+	url := recordingUrl(r, scrubber)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for i, interaction := range c.Interactions {
+		if _, used := c.used[i]; used {
+			continue
+		}
+
+		if interaction.Request.Method != r.Method || interaction.Request.Url != url {
+			continue
+		}
+
+		c.used[i] = struct{}{}
+
+		headers := make(http.Header)
+		for key, values := range interaction.Response.Headers {
+			for _, value := range values {
+				headers.Add(key, scrubber.restorer.Replace(value))
+			}
+		}
+
+		// there's no need to wait between polling requests when replaying
+		headers.Set(autorest.HeaderRetryAfter, "0")
+
+		body := scrubber.restorer.Replace(interaction.Response.Body)
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        headers,
+			Body:          ioutil.NopCloser(strings.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       r,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("No unused interaction was found in the cassette %q for %s %s - it needs to be recorded again", c.path, r.Method, url)
+}
+
+// recordingUrl returns the URL used to match the request, with any secrets, the Resource Manager endpoint and the
+// Subscription/Tenant IDs scrubbed
+func recordingUrl(r *http.Request, scrubber recordingScrubber) string {
+	return scrubber.scrubber.Replace(redactUrl(r.URL).String())
+}
@@ -0,0 +1,244 @@
+package azurerm
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/acctest"
+)
+
+var (
+	// testAccCassettes contains the cassette of each test which is recording or replaying, by the name of the test
+	testAccCassettes     = make(map[string]*cassette)
+	testAccCassettesLock sync.Mutex
+
+	// testAccCassetteInUse is held by the test whose cassette is bound to the clients the Provider configures.
+	// Since the Provider is shared between tests, tests using a cassette (including those calling t.Parallel)
+	// take turns - so that requests are never recorded to (or replayed from) another test's cassette.
+	testAccCassetteInUse sync.Mutex
+)
+
+// testAccCassette returns the cassette for the test when `ARM_TEST_RECORDING_MODE` is set to `record` or `replay`,
+// which the Provider then records requests to (or replays them from) until the test finishes - at which point a
+// recorded cassette is saved. Tests without a cassette are skipped when replaying.
+func testAccCassette(t *testing.T) *cassette {
+	mode := os.Getenv(recordingModeEnvVar)
+	if mode != recordingModeRecord && mode != recordingModeReplay {
+		return nil
+	}
+
+	testAccCassettesLock.Lock()
+	c, ok := testAccCassettes[t.Name()]
+	testAccCassettesLock.Unlock()
+	if ok {
+		return c
+	}
+
+	path := filepath.Join("testdata", "recordings", t.Name()+".json")
+	c, err := loadCassette(path, mode)
+	if err != nil {
+		t.Skipf("Skipping since the cassette couldn't be loaded: %+v", err)
+	}
+
+	testAccCassetteInUse.Lock()
+	setCurrentCassette(c)
+
+	testAccCassettesLock.Lock()
+	testAccCassettes[t.Name()] = c
+	testAccCassettesLock.Unlock()
+
+	t.Cleanup(func() {
+		if !c.replaying() {
+			if err := c.save(); err != nil {
+				t.Errorf("Error saving the cassette %q: %+v", path, err)
+			}
+		}
+
+		testAccCassettesLock.Lock()
+		delete(testAccCassettes, t.Name())
+		testAccCassettesLock.Unlock()
+
+		setCurrentCassette(nil)
+		testAccCassetteInUse.Unlock()
+	})
+
+	return c
+}
+
+// testAccVariable returns the value from the test's cassette when replaying, otherwise the generated value
+// (which is stored in the cassette when recording)
+func testAccVariable(t *testing.T, generate func() string) string {
+	c := testAccCassette(t)
+	if c == nil {
+		return generate()
+	}
+
+	value, err := c.variable(generate)
+	if err != nil {
+		t.Fatalf("Error retrieving the variable from the cassette: %+v", err)
+	}
+
+	return value
+}
+
+func testAccRandInt(t *testing.T) int {
+	value := testAccVariable(t, func() string {
+		return strconv.Itoa(acctest.RandInt())
+	})
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		t.Fatalf("Error parsing the random integer %q from the cassette: %+v", value, err)
+	}
+
+	return i
+}
+
+func testAccRandString(t *testing.T, length int) string {
+	return testAccVariable(t, func() string {
+		return acctest.RandString(length)
+	})
+}
+
+func testAccRandStringFromCharSet(t *testing.T, length int, charSet string) string {
+	return testAccVariable(t, func() string {
+		return acctest.RandStringFromCharSet(length, charSet)
+	})
+}
+
+func TestRecording_RecordAndReplay(t *testing.T) {
+	subscriptionId := "7f68fe06-9404-4db8-a5c7-29639dc4b299"
+	tenantId := "c056adac-c6a6-4ddf-ab20-0f26d47f7eea"
+	resourceUrl := "/subscriptions/" + subscriptionId + "/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example"
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc123")
+
+		if strings.HasSuffix(r.URL.Path, "/listKeys") {
+			w.Write([]byte(`{"keys":[{"keyName":"key1","value":"c3RvcmFnZWtleTE=","permissions":"Full"}]}`))
+			return
+		}
+
+		w.Write([]byte(`{"id":"` + resourceUrl + `","properties":{"provisioningState":"Succeeded","tenantId":"` + tenantId + `"}}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(os.TempDir(), "TestRecording_RecordAndReplay.json")
+	defer os.Remove(path)
+
+	// record the interactions
+	recording, err := loadCassette(path, recordingModeRecord)
+	if err != nil {
+		t.Fatalf("Error creating the cassette: %+v", err)
+	}
+
+	for _, variable := range []string{"1234", "abcd"} {
+		value := variable
+		if _, err := recording.variable(func() string { return value }); err != nil {
+			t.Fatalf("Error recording the variable: %+v", err)
+		}
+	}
+
+	recorder := autorest.CreateSender(withRecording(recording, server.URL, subscriptionId, tenantId))
+	for _, req := range testRecordingRequests(server.URL+resourceUrl, "Bearer secret-token") {
+		resp, err := recorder.Do(req)
+		if err != nil {
+			t.Fatalf("Error recording the request: %+v", err)
+		}
+
+		// the response itself must be unchanged
+		body, _ := ioutil.ReadAll(resp.Body)
+		if strings.HasSuffix(req.URL.Path, "/listKeys") && !strings.Contains(string(body), "c3RvcmFnZWtleTE=") {
+			t.Fatalf("Expected the response to be unchanged when recording but got %q", string(body))
+		}
+	}
+
+	if err := recording.save(); err != nil {
+		t.Fatalf("Error saving the cassette: %+v", err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Error reading the cassette: %+v", err)
+	}
+
+	for _, secret := range []string{"secret-token", "abc123", "c3RvcmFnZWtleTE=", subscriptionId, tenantId, server.URL} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("Expected %q to be scrubbed from the cassette but got:\n%s", secret, string(data))
+		}
+	}
+
+	// then replay them, using a different endpoint and Subscription ID
+	replayEndpoint := "https://management.example.com"
+	replaySubscriptionId := "f36508bb-53b9-4aad-a2ac-2df86acf0c31"
+	replayUrl := strings.Replace(resourceUrl, subscriptionId, replaySubscriptionId, -1)
+	replaying, err := loadCassette(path, recordingModeReplay)
+	if err != nil {
+		t.Fatalf("Error loading the cassette: %+v", err)
+	}
+
+	for _, expected := range []string{"1234", "abcd"} {
+		value, err := replaying.variable(func() string { return "generated" })
+		if err != nil || value != expected {
+			t.Fatalf("Expected the variable %q to be replayed but got %q: %+v", expected, value, err)
+		}
+	}
+
+	if _, err := replaying.variable(func() string { return "generated" }); err == nil {
+		t.Fatalf("Expected an error when the cassette doesn't contain enough variables")
+	}
+
+	requestsWhenRecorded := requests
+	replayer := autorest.CreateSender(withRecording(replaying, replayEndpoint, replaySubscriptionId, tenantId))
+	for _, req := range testRecordingRequests(replayEndpoint+replayUrl, "") {
+		resp, err := replayer.Do(req)
+		if err != nil {
+			t.Fatalf("Error replaying the request: %+v", err)
+		}
+
+		body, _ := ioutil.ReadAll(resp.Body)
+		if !strings.HasSuffix(req.URL.Path, "/listKeys") && !strings.Contains(string(body), replayUrl) {
+			t.Fatalf("Expected the current Subscription ID to be restored in the replayed response but got %q", string(body))
+		}
+
+		if resp.Header.Get(autorest.HeaderRetryAfter) != "0" {
+			t.Fatalf("Expected no delay between replayed requests but got %q", resp.Header.Get(autorest.HeaderRetryAfter))
+		}
+	}
+
+	if requests != requestsWhenRecorded {
+		t.Fatalf("Expected no requests to be sent when replaying but got %d", requests-requestsWhenRecorded)
+	}
+
+	// each interaction can only be replayed once
+	req, _ := http.NewRequest(http.MethodGet, replayEndpoint+replayUrl, nil)
+	if _, err := replayer.Do(req); err == nil {
+		t.Fatalf("Expected an error when no unused interaction matches the request")
+	}
+}
+
+func testRecordingRequests(resourceUrl string, authorization string) []*http.Request {
+	put, _ := http.NewRequest(http.MethodPut, resourceUrl, strings.NewReader(`{"location":"westeurope"}`))
+	get, _ := http.NewRequest(http.MethodGet, resourceUrl, nil)
+	listKeys, _ := http.NewRequest(http.MethodPost, resourceUrl+"/listKeys", nil)
+
+	requests := []*http.Request{put, get, listKeys}
+	for _, req := range requests {
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+	}
+
+	return requests
+}
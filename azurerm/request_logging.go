@@ -0,0 +1,257 @@
+package azurerm
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+)
+
+// redactedValue is logged in place of any secret values
+const redactedValue = "REDACTED"
+
+// sensitiveHeaders are the headers which contain credentials, which are compared case-insensitively
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"Set-Cookie",
+	auxiliaryAuthorizationHeader,
+}
+
+// sensitiveQueryParameters are the query string parameters which contain credentials, such as the
+// signature of a Shared Access Signature
+var sensitiveQueryParameters = []string{
+	"sig",
+}
+
+// sensitiveJsonProperties are the JSON properties known to contain secrets within request and response
+// bodies, which are compared case-insensitively
+var sensitiveJsonProperties = []string{
+	"accessKey",
+	"adminPassword",
+	"administratorLoginPassword",
+	"authorizationKey",
+	"clientSecret",
+	"connectionString",
+	"customData",
+	"password",
+	"primaryAccessKey",
+	"primaryConnectionString",
+	"primaryKey",
+	"primaryMasterKey",
+	"primaryReadonlyMasterKey",
+	"secondaryAccessKey",
+	"secondaryConnectionString",
+	"secondaryKey",
+	"secondaryMasterKey",
+	"secondaryReadonlyMasterKey",
+	"secret",
+	"sharedKey",
+	"storageAccountAccessKey",
+}
+
+// sensitiveOperations are the POST actions whose responses consist entirely of secrets (e.g. the
+// keys for a Storage Account), which are compared case-insensitively against the end of the path
+var sensitiveOperations = []string{
+	"/listAdminKeys",
+	"/listConnectionStrings",
+	"/listCredential",
+	"/listCredentials",
+	"/listKeys",
+	"/listQueryKeys",
+	"/listSecrets",
+	"/listSyncFunctionTriggers",
+	"/regenerateKey",
+	"/regenerateKeys",
+	"/regenerateAccessKey",
+	"/regenerateQueryKey",
+}
+
+// dumpRequest returns the wire format of the request with any secrets redacted - the body is only
+// included when logBody is true.
+func dumpRequest(r *http.Request, logBody bool) ([]byte, error) {
+	logRequest := *r
+	logRequest.Header = redactHeaders(r.Header)
+	logRequest.URL = redactUrl(r.URL)
+
+	if logBody && r.Body != nil {
+		body, err := ioutil.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		redacted := redactJsonBody(body, isKeyVaultSecretRequest(r))
+		logRequest.Body = ioutil.NopCloser(bytes.NewReader(redacted))
+		logRequest.ContentLength = int64(len(redacted))
+	}
+
+	return httputil.DumpRequestOut(&logRequest, logBody)
+}
+
+// dumpResponse returns the wire format of the response with any secrets redacted - the body is only
+// included when logBody is true.
+func dumpResponse(r *http.Request, resp *http.Response, logBody bool) ([]byte, error) {
+	logResponse := *resp
+	logResponse.Header = redactHeaders(resp.Header)
+
+	if logBody && resp.Body != nil {
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		var redacted []byte
+		if isSensitiveOperation(r) && len(body) > 0 {
+			redacted = []byte(redactedValue)
+		} else {
+			redacted = redactJsonBody(body, isKeyVaultSecretRequest(r))
+		}
+		logResponse.Body = ioutil.NopCloser(bytes.NewReader(redacted))
+		logResponse.ContentLength = int64(len(redacted))
+	}
+
+	return httputil.DumpResponse(&logResponse, logBody)
+}
+
+func redactHeaders(input http.Header) http.Header {
+	output := make(http.Header, len(input))
+	for key, values := range input {
+		output[key] = values
+
+		for _, sensitive := range sensitiveHeaders {
+			if strings.EqualFold(key, sensitive) {
+				output[key] = []string{redactedValue}
+				break
+			}
+		}
+	}
+
+	return output
+}
+
+func redactUrl(input *url.URL) *url.URL {
+	if input == nil || input.RawQuery == "" {
+		return input
+	}
+
+	query := input.Query()
+	changed := false
+	for key := range query {
+		for _, sensitive := range sensitiveQueryParameters {
+			if strings.EqualFold(key, sensitive) {
+				query.Set(key, redactedValue)
+				changed = true
+			}
+		}
+	}
+
+	if !changed {
+		return input
+	}
+
+	output := *input
+	output.RawQuery = query.Encode()
+	return &output
+}
+
+// isSensitiveOperation returns whether the request is for an action which returns secrets
+func isSensitiveOperation(r *http.Request) bool {
+	if r == nil || r.URL == nil || r.Method != http.MethodPost {
+		return false
+	}
+
+	path := strings.ToLower(strings.TrimSuffix(r.URL.Path, "/"))
+	for _, operation := range sensitiveOperations {
+		if strings.HasSuffix(path, strings.ToLower(operation)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isKeyVaultSecretRequest returns whether the request is to the Key Vault Data Plane for a Secret,
+// where the `value` property contains the secret itself
+func isKeyVaultSecretRequest(r *http.Request) bool {
+	if r == nil || r.URL == nil {
+		return false
+	}
+
+	return strings.HasPrefix(strings.ToLower(r.URL.Path), "/secrets/")
+}
+
+// redactJsonBody replaces the values of any secret properties within a JSON body - bodies which
+// aren't JSON are returned unchanged.
+func redactJsonBody(body []byte, redactValues bool) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var payload interface{}
+	if err := decoder.Decode(&payload); err != nil {
+		return body
+	}
+
+	if !redactJsonValue(payload, redactValues) {
+		return body
+	}
+
+	output, err := json.Marshal(payload)
+	if err != nil {
+		return body
+	}
+
+	return output
+}
+
+// redactJsonValue recursively redacts the secret properties within the value, returning whether
+// any were redacted
+func redactJsonValue(input interface{}, redactValues bool) bool {
+	redacted := false
+
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSensitiveJsonProperty(key) || (redactValues && strings.EqualFold(key, "value")) {
+				if value != nil && value != "" {
+					v[key] = redactedValue
+					redacted = true
+				}
+				continue
+			}
+
+			if redactJsonValue(value, redactValues) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if redactJsonValue(value, redactValues) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
+func isSensitiveJsonProperty(key string) bool {
+	for _, property := range sensitiveJsonProperties {
+		if strings.EqualFold(key, property) {
+			return true
+		}
+	}
+
+	return false
+}
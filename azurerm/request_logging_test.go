@@ -0,0 +1,178 @@
+package azurerm
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestDumpRequest_Redacted(t *testing.T) {
+	body := `{"location":"westeurope","properties":{"administratorLogin":"sqladmin","administratorLoginPassword":"P@ssw0rd1234!","osProfile":{"adminPassword":"Sup3rS3cret!"}}}`
+	req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2017-05-10", strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer eyJ0eXAiOiJKV1Qi")
+	req.Header.Set(auxiliaryAuthorizationHeader, "Bearer eyJhbGciOiJSUzI1")
+
+	dump, err := dumpRequest(req, true)
+	if err != nil {
+		t.Fatalf("Error dumping the request: %+v", err)
+	}
+
+	output := string(dump)
+	for _, secret := range []string{"eyJ0eXAiOiJKV1Qi", "eyJhbGciOiJSUzI1", "P@ssw0rd1234!", "Sup3rS3cret!"} {
+		if strings.Contains(output, secret) {
+			t.Fatalf("Expected %q to be redacted from the request but got:\n%s", secret, output)
+		}
+	}
+
+	if !strings.Contains(output, "sqladmin") {
+		t.Fatalf("Expected non-secret properties to be logged but got:\n%s", output)
+	}
+
+	// the request itself must be unchanged
+	if actual := req.Header.Get("Authorization"); actual != "Bearer eyJ0eXAiOiJKV1Qi" {
+		t.Fatalf("Expected the Authorization header to be unchanged but got %q", actual)
+	}
+	sent, _ := ioutil.ReadAll(req.Body)
+	if string(sent) != body {
+		t.Fatalf("Expected the request body to be unchanged but got %q", string(sent))
+	}
+}
+
+func TestDumpRequest_WithoutBody(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/example", strings.NewReader(`{"properties":{"displayName":"example"}}`))
+
+	dump, err := dumpRequest(req, false)
+	if err != nil {
+		t.Fatalf("Error dumping the request: %+v", err)
+	}
+
+	if strings.Contains(string(dump), "displayName") {
+		t.Fatalf("Expected the body not to be logged but got:\n%s", dump)
+	}
+}
+
+func TestDumpResponse_Redacted(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Method   string
+		Url      string
+		Body     string
+		Secrets  []string
+		Expected []string
+	}{
+		{
+			Name:     "Storage Account List Keys",
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys?api-version=2017-10-01",
+			Body:     `{"keys":[{"keyName":"key1","value":"c3RvcmFnZWtleTE=","permissions":"Full"}]}`,
+			Secrets:  []string{"c3RvcmFnZWtleTE=", "key1"},
+			Expected: []string{redactedValue},
+		},
+		{
+			Name:     "Key Vault Secret",
+			Method:   http.MethodGet,
+			Url:      "https://example.vault.azure.net/secrets/example/00000000000000000000000000000000?api-version=2016-10-01",
+			Body:     `{"value":"my-secret-value","id":"https://example.vault.azure.net/secrets/example/00000000000000000000000000000000"}`,
+			Secrets:  []string{"my-secret-value"},
+			Expected: []string{"https://example.vault.azure.net/secrets/example"},
+		},
+		{
+			Name:     "Virtual Network Gateway Connection",
+			Method:   http.MethodGet,
+			Url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/connections/example?api-version=2017-09-01",
+			Body:     `{"name":"example","properties":{"sharedKey":"4-v3ry-53cr37-1p53c-5h4r3d-k3y","routingWeight":10}}`,
+			Secrets:  []string{"4-v3ry-53cr37-1p53c-5h4r3d-k3y"},
+			Expected: []string{"routingWeight"},
+		},
+		{
+			Name:     "Resource Group List",
+			Method:   http.MethodGet,
+			Url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups?api-version=2017-05-10",
+			Body:     `{"value":[{"name":"example"}]}`,
+			Expected: []string{`{"value":[{"name":"example"}]}`},
+		},
+	}
+
+	for _, v := range testCases {
+		req, _ := http.NewRequest(v.Method, v.Url, nil)
+		resp := &http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(v.Body)),
+		}
+
+		dump, err := dumpResponse(req, resp, true)
+		if err != nil {
+			t.Fatalf("Error dumping the response for %q: %+v", v.Name, err)
+		}
+
+		output := string(dump)
+		for _, secret := range v.Secrets {
+			if strings.Contains(output, secret) {
+				t.Fatalf("Expected %q to be redacted from the response for %q but got:\n%s", secret, v.Name, output)
+			}
+		}
+
+		for _, expected := range v.Expected {
+			if !strings.Contains(output, expected) {
+				t.Fatalf("Expected %q to be logged in the response for %q but got:\n%s", expected, v.Name, output)
+			}
+		}
+
+		// the response itself must be unchanged
+		received, _ := ioutil.ReadAll(resp.Body)
+		if string(received) != v.Body {
+			t.Fatalf("Expected the response body for %q to be unchanged but got %q", v.Name, string(received))
+		}
+	}
+}
+
+func TestRedactUrl(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://example.blob.core.windows.net/container/blob?sv=2017-04-17&sig=c2lnbmF0dXJl", nil)
+
+	actual := redactUrl(req.URL).String()
+	if strings.Contains(actual, "c2lnbmF0dXJl") {
+		t.Fatalf("Expected the signature to be redacted but got %q", actual)
+	}
+
+	if !strings.Contains(req.URL.String(), "c2lnbmF0dXJl") {
+		t.Fatalf("Expected the original URL to be unchanged but got %q", req.URL.String())
+	}
+}
+
+func TestWithRequestLogging_PassesThrough(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Header.Get("Authorization") != "Bearer token" || string(body) != `{"password":"secret"}` {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"primaryKey":"secret"}`))
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"password":"secret"}`))
+	req.Header.Set("Authorization", "Bearer token")
+
+	resp, err := autorest.CreateSender(withRequestLogging(true)).Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected the request to be sent unchanged but got a %d", resp.StatusCode)
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	if string(body) != `{"primaryKey":"secret"}` {
+		t.Fatalf("Expected the response body to be unchanged but got %q", string(body))
+	}
+}
@@ -0,0 +1,20 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// requiresImport returns whether the resource being created needs to be checked for existence first - since
+// otherwise creating a resource which already exists outside of the State silently takes it over, and can
+// overwrite its settings. This can be disabled using `adopt_existing_resources` (e.g. when migrating).
+func requiresImport(d *schema.ResourceData, meta interface{}) bool {
+	return d.IsNewResource() && !meta.(*ArmClient).adoptExistingResources
+}
+
+// importAsExistsError returns the error raised when the resource being created already exists
+func importAsExistsError(resourceType string, id string) error {
+	return fmt.Errorf("A resource with the ID %q already exists - to be managed via Terraform this resource needs to be imported into the State. "+
+		"Please see the documentation for %q for more information, or set `adopt_existing_resources` in the Provider block to take it over.", id, resourceType)
+}
@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 func TestAccAzureRMAppServiceActiveSlot_basic(t *testing.T) {
 	resourceName := "azurerm_app_service_active_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceActiveSlot_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -31,7 +30,7 @@ func TestAccAzureRMAppServiceActiveSlot_basic(t *testing.T) {
 
 func TestAccAzureRMAppServiceActiveSlot_update(t *testing.T) {
 	resourceName := "azurerm_app_service_active_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceActiveSlot_update(ri, testLocation())
 	config2 := testAccAzureRMAppServiceActiveSlot_updated(ri, testLocation())
 
@@ -4,21 +4,28 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2016-09-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmAppServicePlan() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAppServicePlanCreateUpdate,
-		Read:   resourceArmAppServicePlanRead,
-		Update: resourceArmAppServicePlanCreateUpdate,
-		Delete: resourceArmAppServicePlanDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmAppServicePlanCreateUpdate,
+		Read:     resourceArmAppServicePlanRead,
+		Update:   resourceArmAppServicePlanCreateUpdate,
+		Delete:   resourceArmAppServicePlanDelete,
+		Importer: resourceIDImporter(appServicePlanIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -76,9 +83,10 @@ func resourceArmAppServicePlan() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"app_service_environment_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAppServiceEnvironmentID,
 						},
 						"reserved": {
 							Type:     schema.TypeBool,
@@ -105,13 +113,26 @@ func resourceArmAppServicePlan() *schema.Resource {
 }
 
 func resourceArmAppServicePlanCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).appServicePlansClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).web().appServicePlansClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM App Service Plan creation.")
 
 	resGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
+
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, resGroup, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing App Service Plan %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_app_service_plan", *existing.ID)
+		}
+	}
+
 	location := d.Get("location").(string)
 	kind := d.Get("kind").(string)
 	tags := d.Get("tags").(map[string]interface{})
@@ -123,7 +144,7 @@ func resourceArmAppServicePlanCreateUpdate(d *schema.ResourceData, meta interfac
 		Location:                 &location,
 		AppServicePlanProperties: properties,
 		Kind: &kind,
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 		Sku:  &sku,
 	}
 
@@ -132,7 +153,7 @@ func resourceArmAppServicePlanCreateUpdate(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	err = createFuture.WaitForCompletion(ctx, client.Client)
+	err = waitForCreation(ctx, d, meta, createFuture.Future, client.Client)
 	if err != nil {
 		return err
 	}
@@ -151,9 +172,9 @@ func resourceArmAppServicePlanCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmAppServicePlanRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).appServicePlansClient
+	client := meta.(*ArmClient).web().appServicePlansClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseAppServicePlanID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -161,9 +182,10 @@ func resourceArmAppServicePlanRead(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[DEBUG] Reading Azure App Service Plan %s", id)
 
 	resGroup := id.ResourceGroup
-	name := id.Path["serverfarms"]
+	name := id.Name
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -191,21 +213,22 @@ func resourceArmAppServicePlanRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("sku", flattenAppServicePlanSku(sku))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmAppServicePlanDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).appServicePlansClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).web().appServicePlansClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := parseAppServicePlanID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["serverfarms"]
+	name := id.Name
 
 	log.Printf("[DEBUG] Deleting app service plan %s: %s", resGroup, name)
 
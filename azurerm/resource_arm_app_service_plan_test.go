@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -51,7 +50,7 @@ func TestAzureRMAppServicePlanName_validation(t *testing.T) {
 }
 
 func TestAccAzureRMAppServicePlan_basicWindows(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServicePlan_basicWindows(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -70,7 +69,7 @@ func TestAccAzureRMAppServicePlan_basicWindows(t *testing.T) {
 }
 
 func TestAccAzureRMAppServicePlan_basicLinux(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServicePlan_basicLinux(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -89,7 +88,7 @@ func TestAccAzureRMAppServicePlan_basicLinux(t *testing.T) {
 }
 
 func TestAccAzureRMAppServicePlan_standardWindows(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServicePlan_standardWindows(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -108,7 +107,7 @@ func TestAccAzureRMAppServicePlan_standardWindows(t *testing.T) {
 }
 
 func TestAccAzureRMAppServicePlan_premiumWindows(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServicePlan_premiumWindows(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -128,7 +127,7 @@ func TestAccAzureRMAppServicePlan_premiumWindows(t *testing.T) {
 
 func TestAccAzureRMAppServicePlan_premiumWindowsUpdated(t *testing.T) {
 	resourceName := "azurerm_app_service_plan.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMAppServicePlan_premiumWindows(ri, location)
 	updatedConfig := testAccAzureRMAppServicePlan_premiumWindowsUpdated(ri, location)
@@ -158,7 +157,7 @@ func TestAccAzureRMAppServicePlan_premiumWindowsUpdated(t *testing.T) {
 
 func TestAccAzureRMAppServicePlan_completeWindows(t *testing.T) {
 	resourceName := "azurerm_app_service_plan.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServicePlan_completeWindows(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -179,7 +178,7 @@ func TestAccAzureRMAppServicePlan_completeWindows(t *testing.T) {
 }
 
 func testCheckAzureRMAppServicePlanDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).appServicePlansClient
+	conn := testAccProvider.Meta().(*ArmClient).web().appServicePlansClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_app_service_plan" {
@@ -219,7 +218,7 @@ func testCheckAzureRMAppServicePlanExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for App Service Plan: %s", appServicePlanName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).appServicePlansClient
+		conn := testAccProvider.Meta().(*ArmClient).web().appServicePlansClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := conn.Get(ctx, resourceGroup, appServicePlanName)
 		if err != nil {
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -12,7 +11,7 @@ import (
 
 func TestAccAzureRMAppServiceSlot_basic(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -32,7 +31,7 @@ func TestAccAzureRMAppServiceSlot_basic(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_32Bit(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_32Bit(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -53,7 +52,7 @@ func TestAccAzureRMAppServiceSlot_32Bit(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_alwaysOn(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_alwaysOn(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -74,7 +73,7 @@ func TestAccAzureRMAppServiceSlot_alwaysOn(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_appSettings(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_appSettings(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -95,7 +94,7 @@ func TestAccAzureRMAppServiceSlot_appSettings(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_clientAffinityEnabled(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_clientAffinityEnabled(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -116,7 +115,7 @@ func TestAccAzureRMAppServiceSlot_clientAffinityEnabled(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_connectionStrings(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_connectionStrings(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -139,7 +138,7 @@ func TestAccAzureRMAppServiceSlot_connectionStrings(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_defaultDocuments(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_defaultDocuments(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -162,7 +161,7 @@ func TestAccAzureRMAppServiceSlot_defaultDocuments(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_enabled(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_enabled(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -183,7 +182,7 @@ func TestAccAzureRMAppServiceSlot_enabled(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_localMySql(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_localMySql(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -204,7 +203,7 @@ func TestAccAzureRMAppServiceSlot_localMySql(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_managedPipelineMode(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_managedPipelineMode(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -225,7 +224,7 @@ func TestAccAzureRMAppServiceSlot_managedPipelineMode(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_tagsUpdate(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_tags(ri, testLocation())
 	updatedConfig := testAccAzureRMAppServiceSlot_tagsUpdated(ri, testLocation())
 
@@ -257,7 +256,7 @@ func TestAccAzureRMAppServiceSlot_tagsUpdate(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_remoteDebugging(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_remoteDebugging(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -279,7 +278,7 @@ func TestAccAzureRMAppServiceSlot_remoteDebugging(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_windowsDotNet2(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_windowsDotNet(ri, testLocation(), "v2.0")
 
 	resource.Test(t, resource.TestCase{
@@ -300,7 +299,7 @@ func TestAccAzureRMAppServiceSlot_windowsDotNet2(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_windowsDotNet4(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_windowsDotNet(ri, testLocation(), "v4.0")
 
 	resource.Test(t, resource.TestCase{
@@ -321,7 +320,7 @@ func TestAccAzureRMAppServiceSlot_windowsDotNet4(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_windowsDotNetUpdate(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_windowsDotNet(ri, testLocation(), "v2.0")
 	updatedConfig := testAccAzureRMAppServiceSlot_windowsDotNet(ri, testLocation(), "v4.0")
 
@@ -350,7 +349,7 @@ func TestAccAzureRMAppServiceSlot_windowsDotNetUpdate(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_windowsJava7Jetty(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_windowsJava(ri, testLocation(), "1.7", "JETTY", "9.3")
 
 	resource.Test(t, resource.TestCase{
@@ -373,7 +372,7 @@ func TestAccAzureRMAppServiceSlot_windowsJava7Jetty(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_windowsJava8Jetty(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_windowsJava(ri, testLocation(), "1.8", "JETTY", "9.3")
 
 	resource.Test(t, resource.TestCase{
@@ -395,7 +394,7 @@ func TestAccAzureRMAppServiceSlot_windowsJava8Jetty(t *testing.T) {
 }
 func TestAccAzureRMAppServiceSlot_windowsJava7Tomcat(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_windowsJava(ri, testLocation(), "1.7", "TOMCAT", "9.0")
 
 	resource.Test(t, resource.TestCase{
@@ -418,7 +417,7 @@ func TestAccAzureRMAppServiceSlot_windowsJava7Tomcat(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_windowsJava8Tomcat(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_windowsJava(ri, testLocation(), "1.8", "TOMCAT", "9.0")
 
 	resource.Test(t, resource.TestCase{
@@ -441,7 +440,7 @@ func TestAccAzureRMAppServiceSlot_windowsJava8Tomcat(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_windowsPHP7(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_windowsPHP(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -462,7 +461,7 @@ func TestAccAzureRMAppServiceSlot_windowsPHP7(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_windowsPython(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_windowsPython(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -483,7 +482,7 @@ func TestAccAzureRMAppServiceSlot_windowsPython(t *testing.T) {
 
 func TestAccAzureRMAppServiceSlot_webSockets(t *testing.T) {
 	resourceName := "azurerm_app_service_slot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppServiceSlot_webSockets(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -503,7 +502,7 @@ func TestAccAzureRMAppServiceSlot_webSockets(t *testing.T) {
 }
 
 func testCheckAzureRMAppServiceSlotDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).appServicesClient
+	client := testAccProvider.Meta().(*ArmClient).web().appServicesClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_app_service_slot" {
@@ -544,7 +543,7 @@ func testCheckAzureRMAppServiceSlotExists(slot string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for App Service Slot: %q/%q", appServiceName, slot)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).appServicesClient
+		client := testAccProvider.Meta().(*ArmClient).web().appServicesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.GetSlot(ctx, resourceGroup, appServiceName, slot)
 		if err != nil {
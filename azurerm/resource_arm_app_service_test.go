@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -52,7 +51,7 @@ func TestAzureRMAppServiceName_validation(t *testing.T) {
 
 func TestAccAzureRMAppService_basic(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -72,7 +71,7 @@ func TestAccAzureRMAppService_basic(t *testing.T) {
 
 func TestAccAzureRMAppService_freeTier(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_freeTier(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -92,7 +91,7 @@ func TestAccAzureRMAppService_freeTier(t *testing.T) {
 
 func TestAccAzureRMAppService_sharedTier(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_sharedTier(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -112,7 +111,7 @@ func TestAccAzureRMAppService_sharedTier(t *testing.T) {
 
 func TestAccAzureRMAppService_32Bit(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_32Bit(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -133,7 +132,7 @@ func TestAccAzureRMAppService_32Bit(t *testing.T) {
 
 func TestAccAzureRMAppService_alwaysOn(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_alwaysOn(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -154,7 +153,7 @@ func TestAccAzureRMAppService_alwaysOn(t *testing.T) {
 
 func TestAccAzureRMAppService_appSettings(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_appSettings(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -175,7 +174,7 @@ func TestAccAzureRMAppService_appSettings(t *testing.T) {
 
 func TestAccAzureRMAppService_clientAffinityEnabled(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_clientAffinityEnabled(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -196,7 +195,7 @@ func TestAccAzureRMAppService_clientAffinityEnabled(t *testing.T) {
 
 func TestAccAzureRMAppService_connectionStrings(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_connectionStrings(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -219,7 +218,7 @@ func TestAccAzureRMAppService_connectionStrings(t *testing.T) {
 
 func TestAccAzureRMAppService_defaultDocuments(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_defaultDocuments(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -242,7 +241,7 @@ func TestAccAzureRMAppService_defaultDocuments(t *testing.T) {
 
 func TestAccAzureRMAppService_enabled(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_enabled(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -263,7 +262,7 @@ func TestAccAzureRMAppService_enabled(t *testing.T) {
 
 func TestAccAzureRMAppService_localMySql(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_localMySql(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -284,7 +283,7 @@ func TestAccAzureRMAppService_localMySql(t *testing.T) {
 
 func TestAccAzureRMAppService_managedPipelineMode(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_managedPipelineMode(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -305,7 +304,7 @@ func TestAccAzureRMAppService_managedPipelineMode(t *testing.T) {
 
 func TestAccAzureRMAppService_tagsUpdate(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_tags(ri, testLocation())
 	updatedConfig := testAccAzureRMAppService_tagsUpdated(ri, testLocation())
 
@@ -337,7 +336,7 @@ func TestAccAzureRMAppService_tagsUpdate(t *testing.T) {
 
 func TestAccAzureRMAppService_remoteDebugging(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_remoteDebugging(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -359,7 +358,7 @@ func TestAccAzureRMAppService_remoteDebugging(t *testing.T) {
 
 func TestAccAzureRMAppService_windowsDotNet2(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsDotNet(ri, testLocation(), "v2.0")
 
 	resource.Test(t, resource.TestCase{
@@ -380,7 +379,7 @@ func TestAccAzureRMAppService_windowsDotNet2(t *testing.T) {
 
 func TestAccAzureRMAppService_windowsDotNet4(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsDotNet(ri, testLocation(), "v4.0")
 
 	resource.Test(t, resource.TestCase{
@@ -401,7 +400,7 @@ func TestAccAzureRMAppService_windowsDotNet4(t *testing.T) {
 
 func TestAccAzureRMAppService_windowsDotNetUpdate(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsDotNet(ri, testLocation(), "v2.0")
 	updatedConfig := testAccAzureRMAppService_windowsDotNet(ri, testLocation(), "v4.0")
 
@@ -430,7 +429,7 @@ func TestAccAzureRMAppService_windowsDotNetUpdate(t *testing.T) {
 
 func TestAccAzureRMAppService_windowsJava7Jetty(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsJava(ri, testLocation(), "1.7", "JETTY", "9.3")
 
 	resource.Test(t, resource.TestCase{
@@ -453,7 +452,7 @@ func TestAccAzureRMAppService_windowsJava7Jetty(t *testing.T) {
 
 func TestAccAzureRMAppService_windowsJava8Jetty(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsJava(ri, testLocation(), "1.8", "JETTY", "9.3")
 
 	resource.Test(t, resource.TestCase{
@@ -475,7 +474,7 @@ func TestAccAzureRMAppService_windowsJava8Jetty(t *testing.T) {
 }
 func TestAccAzureRMAppService_windowsJava7Tomcat(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsJava(ri, testLocation(), "1.7", "TOMCAT", "9.0")
 
 	resource.Test(t, resource.TestCase{
@@ -498,7 +497,7 @@ func TestAccAzureRMAppService_windowsJava7Tomcat(t *testing.T) {
 
 func TestAccAzureRMAppService_windowsJava8Tomcat(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsJava(ri, testLocation(), "1.8", "TOMCAT", "9.0")
 
 	resource.Test(t, resource.TestCase{
@@ -521,7 +520,7 @@ func TestAccAzureRMAppService_windowsJava8Tomcat(t *testing.T) {
 
 func TestAccAzureRMAppService_windowsPHP7(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsPHP(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -542,7 +541,7 @@ func TestAccAzureRMAppService_windowsPHP7(t *testing.T) {
 
 func TestAccAzureRMAppService_windowsPython(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_windowsPython(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -563,7 +562,7 @@ func TestAccAzureRMAppService_windowsPython(t *testing.T) {
 
 func TestAccAzureRMAppService_webSockets(t *testing.T) {
 	resourceName := "azurerm_app_service.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAppService_webSockets(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -583,7 +582,7 @@ func TestAccAzureRMAppService_webSockets(t *testing.T) {
 }
 
 func testCheckAzureRMAppServiceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).appServicesClient
+	client := testAccProvider.Meta().(*ArmClient).web().appServicesClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_app_service" {
@@ -623,7 +622,7 @@ func testCheckAzureRMAppServiceExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for App Service: %s", appServiceName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).appServicesClient
+		client := testAccProvider.Meta().(*ArmClient).web().appServicesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, appServiceName)
 		if err != nil {
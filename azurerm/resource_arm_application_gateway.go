@@ -2,26 +2,42 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var applicationGatewayIDFormat = resourceIDFormat{
+	description: "Application Gateway",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "applicationGateways", placeholder: "applicationGatewayName"},
+	},
+}
+
 func resourceArmApplicationGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApplicationGatewayCreateUpdate,
-		Read:   resourceArmApplicationGatewayRead,
-		Update: resourceArmApplicationGatewayCreateUpdate,
-		Delete: resourceArmApplicationGatewayDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApplicationGatewayCreateUpdate,
+		Read:     resourceArmApplicationGatewayRead,
+		Update:   resourceArmApplicationGatewayCreateUpdate,
+		Delete:   resourceArmApplicationGatewayDelete,
+		Importer: resourceIDImporter(applicationGatewayIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -149,8 +165,9 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"subnet_id": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSubnetID,
 						},
 					},
 				},
@@ -196,9 +213,10 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"subnet_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateSubnetID,
 						},
 
 						"private_ip_address": {
@@ -208,9 +226,10 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"public_ip_address_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validatePublicIPAddressID,
 						},
 
 						"private_ip_address_allocation": {
@@ -680,14 +699,27 @@ func resourceArmApplicationGateway() *schema.Resource {
 
 func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	client := armClient.applicationGatewayClient
-	ctx := armClient.StopContext
+	client := armClient.networking().applicationGatewayClient
+	ctx, cancel := timeouts.ForCreateUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM ApplicationGateway creation.")
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
+
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, resGroup, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_application_gateway", *existing.ID)
+		}
+	}
+
 	tags := d.Get("tags").(map[string]interface{})
 
 	// Gateway ID is needed to link sub-resources together in expand functions
@@ -717,7 +749,7 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 	gateway := network.ApplicationGateway{
 		Name:     utils.String(name),
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		ApplicationGatewayPropertiesFormat: &properties,
 	}
 
@@ -726,7 +758,7 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error Creating/Updating ApplicationGateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletion(ctx, client.Client)
+	err = waitForCreation(ctx, d, meta, future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error Creating/Updating ApplicationGateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -745,12 +777,15 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return errwrap.Wrapf("Error parsing ApplicationGateway ID {{err}}", err)
 	}
 
-	applicationGateway, exists, err := retrieveApplicationGatewayById(d.Id(), meta)
+	applicationGateway, exists, err := retrieveApplicationGatewayById(ctx, d.Id(), meta)
 	if err != nil {
 		return errwrap.Wrapf("Error Getting ApplicationGateway By ID {{err}}", err)
 	}
@@ -804,14 +839,15 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 			flattenApplicationGatewayWafConfig(applicationGateway.ApplicationGatewayPropertiesFormat.WebApplicationFirewallConfiguration)))
 	}
 
-	flattenAndSetTags(d, applicationGateway.Tags)
+	flattenAndSetTags(d, applicationGateway.Tags, meta)
 
 	return nil
 }
 
 func resourceArmApplicationGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).applicationGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).networking().applicationGatewayClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -845,9 +881,8 @@ func ApplicationGatewayResGroupAndNameFromID(ApplicationGatewayID string) (strin
 	return resGroup, name, nil
 }
 
-func retrieveApplicationGatewayById(applicationGatewayID string, meta interface{}) (*network.ApplicationGateway, bool, error) {
-	client := meta.(*ArmClient).applicationGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+func retrieveApplicationGatewayById(ctx context.Context, applicationGatewayID string, meta interface{}) (*network.ApplicationGateway, bool, error) {
+	client := meta.(*ArmClient).networking().applicationGatewayClient
 
 	resGroup, name, err := ApplicationGatewayResGroupAndNameFromID(applicationGatewayID)
 	if err != nil {
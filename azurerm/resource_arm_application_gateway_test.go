@@ -7,7 +7,6 @@ import (
 
 	"log"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
@@ -27,7 +26,7 @@ func testSweepApplicationGateways(region string) error {
 		return err
 	}
 
-	client := (*armClient).applicationGatewayClient
+	client := (*armClient).networking().applicationGatewayClient
 	ctx := (*armClient).StopContext
 
 	log.Printf("Retrieving the Application Gateways..")
@@ -75,7 +74,7 @@ func testSweepApplicationGateways(region string) error {
 
 func TestAccAzureRMApplicationGateway_basic_base(t *testing.T) {
 	resourceName := "azurerm_application_gateway.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -95,7 +94,7 @@ func TestAccAzureRMApplicationGateway_basic_base(t *testing.T) {
 
 func TestAccAzureRMApplicationGateway_basic_changeSslCert(t *testing.T) {
 	resourceName := "azurerm_application_gateway.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -123,7 +122,7 @@ func TestAccAzureRMApplicationGateway_basic_changeSslCert(t *testing.T) {
 
 func TestAccAzureRMApplicationGateway_basic_authCert(t *testing.T) {
 	resourceName := "azurerm_application_gateway.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -144,7 +143,7 @@ func TestAccAzureRMApplicationGateway_basic_authCert(t *testing.T) {
 
 func TestAccAzureRMApplicationGateway_basic_changeAuthCert(t *testing.T) {
 	resourceName := "azurerm_application_gateway.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -174,7 +173,7 @@ func TestAccAzureRMApplicationGateway_basic_changeAuthCert(t *testing.T) {
 
 func TestAccAzureRMApplicationGateway_waf(t *testing.T) {
 	resourceName := "azurerm_application_gateway.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
 	gwID := fmt.Sprintf(
@@ -211,7 +210,7 @@ func testCheckAzureRMApplicationGatewayExists(name string) resource.TestCheckFun
 			return fmt.Errorf("Bad: no resource group found in state for App Gateway: %q", ApplicationGatewayName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).applicationGatewayClient
+		conn := testAccProvider.Meta().(*ArmClient).networking().applicationGatewayClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, ApplicationGatewayName)
@@ -240,7 +239,7 @@ func testCheckAzureRMApplicationGatewaySslCertificateAssigned(name string, certN
 			return fmt.Errorf("Bad: no resource group found in state for App Gateway: %q", gatewayName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).applicationGatewayClient
+		conn := testAccProvider.Meta().(*ArmClient).networking().applicationGatewayClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, gatewayName)
@@ -287,7 +286,7 @@ func testCheckAzureRMApplicationGatewayAuthenticationCertificateAssigned(name st
 			return fmt.Errorf("Bad: no resource group found in state for App Gateway: %q", gatewayName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).applicationGatewayClient
+		conn := testAccProvider.Meta().(*ArmClient).networking().applicationGatewayClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, gatewayName)
@@ -326,7 +325,7 @@ func testCheckAzureRMApplicationGatewayAuthenticationCertificateAssigned(name st
 }
 
 func testCheckAzureRMApplicationGatewayDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).applicationGatewayClient
+	conn := testAccProvider.Meta().(*ArmClient).networking().applicationGatewayClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMAutomationAccount_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	resourceName := "azurerm_automation_account.test"
 	config := testAccAzureRMAutomationAccount_basic(ri, testLocation())
 
@@ -32,7 +31,7 @@ func TestAccAzureRMAutomationAccount_basic(t *testing.T) {
 }
 
 func TestAccAzureRMAutomationAccount_complete(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	resourceName := "azurerm_automation_account.test"
 	config := testAccAzureRMAutomationAccount_complete(ri, testLocation())
 
@@ -54,7 +53,7 @@ func TestAccAzureRMAutomationAccount_complete(t *testing.T) {
 }
 
 func testCheckAzureRMAutomationAccountDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).automationAccountClient
+	conn := testAccProvider.Meta().(*ArmClient).automation().automationAccountClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -96,7 +95,7 @@ func testCheckAzureRMAutomationAccountExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for Automation Account: '%s'", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).automationAccountClient
+		conn := testAccProvider.Meta().(*ArmClient).automation().automationAccountClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
@@ -3,20 +3,36 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var automationCredentialIDFormat = resourceIDFormat{
+	description: "Automation Credential",
+	provider:    "Microsoft.Automation",
+	segments: []resourceIDSegment{
+		{key: "automationAccounts", placeholder: "automationAccountName"},
+		{key: "credentials", placeholder: "credentialName"},
+	},
+}
+
 func resourceArmAutomationCredential() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAutomationCredentialCreateUpdate,
-		Read:   resourceArmAutomationCredentialRead,
-		Update: resourceArmAutomationCredentialCreateUpdate,
-		Delete: resourceArmAutomationCredentialDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmAutomationCredentialCreateUpdate,
+		Read:     resourceArmAutomationCredentialRead,
+		Update:   resourceArmAutomationCredentialCreateUpdate,
+		Delete:   resourceArmAutomationCredentialDelete,
+		Importer: resourceIDImporter(automationCredentialIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -51,14 +67,27 @@ func resourceArmAutomationCredential() *schema.Resource {
 }
 
 func resourceArmAutomationCredentialCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).automationCredentialClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).automation().automationCredentialClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Automation Credential creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 	client.ResourceGroupName = resGroup
 	accName := d.Get("account_name").(string)
+
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, accName, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Automation Credential %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_automation_credential", *existing.ID)
+		}
+	}
+
 	user := d.Get("username").(string)
 	password := d.Get("password").(string)
 	description := d.Get("description").(string)
@@ -92,8 +121,9 @@ func resourceArmAutomationCredentialCreateUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceArmAutomationCredentialRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).automationCredentialClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).automation().automationCredentialClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
@@ -125,8 +155,9 @@ func resourceArmAutomationCredentialRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceArmAutomationCredentialDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).automationCredentialClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).automation().automationCredentialClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -3,21 +3,37 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var automationRunbookIDFormat = resourceIDFormat{
+	description: "Automation Runbook",
+	provider:    "Microsoft.Automation",
+	segments: []resourceIDSegment{
+		{key: "automationAccounts", placeholder: "automationAccountName"},
+		{key: "runbooks", placeholder: "runbookName"},
+	},
+}
+
 func resourceArmAutomationRunbook() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAutomationRunbookCreateUpdate,
-		Read:   resourceArmAutomationRunbookRead,
-		Update: resourceArmAutomationRunbookCreateUpdate,
-		Delete: resourceArmAutomationRunbookDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmAutomationRunbookCreateUpdate,
+		Read:     resourceArmAutomationRunbookRead,
+		Update:   resourceArmAutomationRunbookCreateUpdate,
+		Delete:   resourceArmAutomationRunbookDelete,
+		Importer: resourceIDImporter(automationRunbookIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -109,8 +125,9 @@ func resourceArmAutomationRunbook() *schema.Resource {
 }
 
 func resourceArmAutomationRunbookCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).automationRunbookClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).automation().automationRunbookClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Automation Runbook creation.")
 
 	name := d.Get("name").(string)
@@ -120,6 +137,18 @@ func resourceArmAutomationRunbookCreateUpdate(d *schema.ResourceData, meta inter
 	tags := d.Get("tags").(map[string]interface{})
 
 	accName := d.Get("account_name").(string)
+
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, accName, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Automation Runbook %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_automation_runbook", *existing.ID)
+		}
+	}
+
 	runbookType := automation.RunbookTypeEnum(d.Get("runbook_type").(string))
 	logProgress := d.Get("log_progress").(bool)
 	logVerbose := d.Get("log_verbose").(bool)
@@ -137,7 +166,7 @@ func resourceArmAutomationRunbookCreateUpdate(d *schema.ResourceData, meta inter
 		},
 
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 	}
 
 	_, err := client.CreateOrUpdate(ctx, accName, name, parameters)
@@ -160,8 +189,9 @@ func resourceArmAutomationRunbookCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceArmAutomationRunbookRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).automationRunbookClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).automation().automationRunbookClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
@@ -193,14 +223,15 @@ func resourceArmAutomationRunbookRead(d *schema.ResourceData, meta interface{})
 		d.Set("description", props.Description)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmAutomationRunbookDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).automationRunbookClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).automation().automationRunbookClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -9,17 +9,32 @@ import (
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var automationScheduleIDFormat = resourceIDFormat{
+	description: "Automation Schedule",
+	provider:    "Microsoft.Automation",
+	segments: []resourceIDSegment{
+		{key: "automationAccounts", placeholder: "automationAccountName"},
+		{key: "schedules", placeholder: "scheduleName"},
+	},
+}
+
 func resourceArmAutomationSchedule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAutomationScheduleCreateUpdate,
-		Read:   resourceArmAutomationScheduleRead,
-		Update: resourceArmAutomationScheduleCreateUpdate,
-		Delete: resourceArmAutomationScheduleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmAutomationScheduleCreateUpdate,
+		Read:     resourceArmAutomationScheduleRead,
+		Update:   resourceArmAutomationScheduleCreateUpdate,
+		Delete:   resourceArmAutomationScheduleDelete,
+		Importer: resourceIDImporter(automationScheduleIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -103,8 +118,9 @@ func validateStartTime(v interface{}, k string) (ws []string, errors []error) {
 }
 
 func resourceArmAutomationScheduleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).automationScheduleClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).automation().automationScheduleClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Automation Schedule creation.")
 
 	name := d.Get("name").(string)
@@ -112,6 +128,18 @@ func resourceArmAutomationScheduleCreateUpdate(d *schema.ResourceData, meta inte
 	client.ResourceGroupName = resGroup
 
 	accName := d.Get("account_name").(string)
+
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, accName, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Automation Schedule %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_automation_schedule", *existing.ID)
+		}
+	}
+
 	freqstr := d.Get("frequency").(string)
 	freq := automation.ScheduleFrequency(freqstr)
 
@@ -163,8 +191,9 @@ func resourceArmAutomationScheduleCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceArmAutomationScheduleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).automationScheduleClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).automation().automationScheduleClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
@@ -196,8 +225,9 @@ func resourceArmAutomationScheduleRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmAutomationScheduleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).automationScheduleClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).automation().automationScheduleClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
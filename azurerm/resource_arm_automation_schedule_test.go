@@ -6,14 +6,13 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMAutomationSchedule_oneTime(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMAutomationSchedule_oneTime(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -32,7 +31,7 @@ func TestAccAzureRMAutomationSchedule_oneTime(t *testing.T) {
 }
 
 func testCheckAzureRMAutomationScheduleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).automationScheduleClient
+	conn := testAccProvider.Meta().(*ArmClient).automation().automationScheduleClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -81,7 +80,7 @@ func testCheckAzureRMAutomationScheduleExistsAndFrequencyType(name string, freq
 			return fmt.Errorf("Bad: no resource group found in state for Automation Schedule: '%s'", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).automationScheduleClient
+		conn := testAccProvider.Meta().(*ArmClient).automation().automationScheduleClient
 		conn.ResourceGroupName = resourceGroup
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
@@ -5,22 +5,38 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2017-04-02/cdn"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var cdnEndpointIDFormat = resourceIDFormat{
+	description: "CDN Endpoint",
+	provider:    "Microsoft.Cdn",
+	segments: []resourceIDSegment{
+		{key: "profiles", placeholder: "profileName"},
+		{key: "endpoints", placeholder: "endpointName"},
+	},
+}
+
 func resourceArmCdnEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmCdnEndpointCreate,
-		Read:   resourceArmCdnEndpointRead,
-		Update: resourceArmCdnEndpointUpdate,
-		Delete: resourceArmCdnEndpointDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmCdnEndpointCreate,
+		Read:     resourceArmCdnEndpointRead,
+		Update:   resourceArmCdnEndpointUpdate,
+		Delete:   resourceArmCdnEndpointDelete,
+		Importer: resourceIDImporter(cdnEndpointIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -130,8 +146,9 @@ func resourceArmCdnEndpoint() *schema.Resource {
 }
 
 func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cdnEndpointsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).cdn().cdnEndpointsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM CDN EndPoint creation.")
 
@@ -139,6 +156,18 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
 	profileName := d.Get("profile_name").(string)
+
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, resGroup, profileName, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing CDN Endpoint %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_cdn_endpoint", *existing.ID)
+		}
+	}
+
 	http_allowed := d.Get("is_http_allowed").(bool)
 	https_allowed := d.Get("is_https_allowed").(bool)
 	compression_enabled := d.Get("is_compression_enabled").(bool)
@@ -184,7 +213,7 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 	cdnEndpoint := cdn.Endpoint{
 		Location:           &location,
 		EndpointProperties: &properties,
-		Tags:               expandTags(tags),
+		Tags:               expandTags(tags, meta),
 	}
 
 	future, err := client.Create(ctx, resGroup, profileName, name, cdnEndpoint)
@@ -192,7 +221,7 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	err = future.WaitForCompletion(ctx, client.Client)
+	err = waitForCreation(ctx, d, meta, future.Future, client.Client)
 	if err != nil {
 		return err
 	}
@@ -211,8 +240,9 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceArmCdnEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cdnEndpointsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).cdn().cdnEndpointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -254,14 +284,15 @@ func resourceArmCdnEndpointRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("origin", flattenAzureRMCdnEndpointOrigin(resp.EndpointProperties.Origins))
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cdnEndpointsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).cdn().cdnEndpointsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
@@ -301,7 +332,7 @@ func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	updateProps := cdn.EndpointUpdateParameters{
-		Tags: expandTags(newTags),
+		Tags: expandTags(newTags, meta),
 		EndpointPropertiesUpdateParameters: &properties,
 	}
 
@@ -319,8 +350,9 @@ func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceArmCdnEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cdnEndpointsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).cdn().cdnEndpointsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
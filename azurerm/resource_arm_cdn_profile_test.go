@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -24,7 +23,7 @@ func testSweepCDNProfiles(region string) error {
 		return err
 	}
 
-	client := (*armClient).cdnProfilesClient
+	client := (*armClient).cdn().cdnProfilesClient
 	ctx := (*armClient).StopContext
 
 	log.Printf("Retrieving the CDN Profiles..")
@@ -102,7 +101,7 @@ func TestResourceAzureRMCdnProfileSKU_validation(t *testing.T) {
 }
 
 func TestAccAzureRMCdnProfile_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCdnProfile_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -122,7 +121,7 @@ func TestAccAzureRMCdnProfile_basic(t *testing.T) {
 
 func TestAccAzureRMCdnProfile_withTags(t *testing.T) {
 	resourceName := "azurerm_cdn_profile.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	preConfig := testAccAzureRMCdnProfile_withTags(ri, location)
 	postConfig := testAccAzureRMCdnProfile_withTagsUpdate(ri, location)
@@ -155,7 +154,7 @@ func TestAccAzureRMCdnProfile_withTags(t *testing.T) {
 }
 
 func TestAccAzureRMCdnProfile_NonStandardCasing(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCdnProfileNonStandardCasing(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -192,7 +191,7 @@ func testCheckAzureRMCdnProfileExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for cdn profile: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).cdnProfilesClient
+		conn := testAccProvider.Meta().(*ArmClient).cdn().cdnProfilesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
@@ -209,7 +208,7 @@ func testCheckAzureRMCdnProfileExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMCdnProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).cdnProfilesClient
+	conn := testAccProvider.Meta().(*ArmClient).cdn().cdnProfilesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
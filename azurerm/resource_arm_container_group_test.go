@@ -5,7 +5,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -13,7 +12,7 @@ import (
 
 func TestAccAzureRMContainerGroup_linuxBasic(t *testing.T) {
 	resourceName := "azurerm_container_group.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	config := testAccAzureRMContainerGroup_linuxBasic(ri, testLocation())
 
@@ -36,7 +35,7 @@ func TestAccAzureRMContainerGroup_linuxBasic(t *testing.T) {
 
 func TestAccAzureRMContainerGroup_linuxBasicUpdate(t *testing.T) {
 	resourceName := "azurerm_container_group.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	config := testAccAzureRMContainerGroup_linuxBasic(ri, testLocation())
 	updatedConfig := testAccAzureRMContainerGroup_linuxBasicUpdated(ri, testLocation())
@@ -66,7 +65,7 @@ func TestAccAzureRMContainerGroup_linuxBasicUpdate(t *testing.T) {
 
 func TestAccAzureRMContainerGroup_linuxComplete(t *testing.T) {
 	resourceName := "azurerm_container_group.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	config := testAccAzureRMContainerGroup_linuxComplete(ri, testLocation())
 
@@ -98,7 +97,7 @@ func TestAccAzureRMContainerGroup_linuxComplete(t *testing.T) {
 
 func TestAccAzureRMContainerGroup_windowsBasic(t *testing.T) {
 	resourceName := "azurerm_container_group.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	config := testAccAzureRMContainerGroup_windowsBasic(ri, testLocation())
 
@@ -121,7 +120,7 @@ func TestAccAzureRMContainerGroup_windowsBasic(t *testing.T) {
 
 func TestAccAzureRMContainerGroup_windowsComplete(t *testing.T) {
 	resourceName := "azurerm_container_group.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	config := testAccAzureRMContainerGroup_windowsComplete(ri, testLocation())
 
@@ -359,7 +358,7 @@ func testCheckAzureRMContainerGroupExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Container Registry: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).containerGroupsClient
+		conn := testAccProvider.Meta().(*ArmClient).containerInstance().containerGroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
@@ -375,7 +374,7 @@ func testCheckAzureRMContainerGroupExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMContainerGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).containerGroupsClient
+	conn := testAccProvider.Meta().(*ArmClient).containerInstance().containerGroupsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
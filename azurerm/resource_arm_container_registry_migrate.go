@@ -91,7 +91,7 @@ func updateV1ToV2StorageAccountName(is *terraform.InstanceState, meta interface{
 }
 
 func findAzureStorageAccountIdFromName(name string, meta interface{}) (string, error) {
-	client := meta.(*ArmClient).storageServiceClient
+	client := meta.(*ArmClient).storage().storageServiceClient
 	accounts, err := client.List()
 	if err != nil {
 		return "", err
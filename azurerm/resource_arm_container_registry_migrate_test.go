@@ -8,7 +8,6 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -26,7 +25,7 @@ func TestAccAzureRMContainerRegistryMigrateState(t *testing.T) {
 
 	client.StopContext = testAccProvider.StopContext()
 
-	rs := acctest.RandString(4)
+	rs := testAccRandString(t, 4)
 	resourceGroupName := fmt.Sprintf("acctestrg%s", rs)
 	storageAccountName := fmt.Sprintf("acctestsa%s", rs)
 	location := azureRMNormalizeLocation(testLocation())
@@ -101,7 +100,7 @@ func createResourceGroup(ctx context.Context, client *ArmClient, resourceGroupNa
 	group := resources.Group{
 		Location: &location,
 	}
-	_, err := client.resourceGroupsClient.CreateOrUpdate(ctx, resourceGroupName, group)
+	_, err := client.resources().resourceGroupsClient.CreateOrUpdate(ctx, resourceGroupName, group)
 	if err != nil {
 		return fmt.Errorf("Error creating Resource Group %q: %+v", resourceGroupName, err)
 	}
@@ -109,7 +108,7 @@ func createResourceGroup(ctx context.Context, client *ArmClient, resourceGroupNa
 }
 
 func createStorageAccount(client *ArmClient, resourceGroupName, storageAccountName, location string) (*storage.Account, error) {
-	storageClient := client.storageServiceClient
+	storageClient := client.storage().storageServiceClient
 	createParams := storage.AccountCreateParameters{
 		Location: &location,
 		Kind:     storage.Storage,
@@ -131,6 +130,6 @@ func createStorageAccount(client *ArmClient, resourceGroupName, storageAccountNa
 
 func destroyStorageAccountAndResourceGroup(client *ArmClient, resourceGroupName, storageAccountName string) {
 	ctx := client.StopContext
-	client.storageServiceClient.Delete(resourceGroupName, storageAccountName)
-	client.resourceGroupsClient.Delete(ctx, resourceGroupName)
+	client.storage().storageServiceClient.Delete(resourceGroupName, storageAccountName)
+	client.resources().resourceGroupsClient.Delete(ctx, resourceGroupName)
 }
@@ -5,7 +5,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -68,8 +67,8 @@ func TestAccAzureRMContainerRegistryName_validation(t *testing.T) {
 }
 
 func TestAccAzureRMContainerRegistry_basicClassic(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	config := testAccAzureRMContainerRegistry_basicUnmanaged(ri, rs, testLocation(), "Classic")
 
 	resource.Test(t, resource.TestCase{
@@ -88,7 +87,7 @@ func TestAccAzureRMContainerRegistry_basicClassic(t *testing.T) {
 }
 
 func TestAccAzureRMContainerRegistry_basicBasic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMContainerRegistry_basicManaged(ri, testLocation(), "Basic")
 
 	resource.Test(t, resource.TestCase{
@@ -107,7 +106,7 @@ func TestAccAzureRMContainerRegistry_basicBasic(t *testing.T) {
 }
 
 func TestAccAzureRMContainerRegistry_basicStandard(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMContainerRegistry_basicManaged(ri, testLocation(), "Standard")
 
 	resource.Test(t, resource.TestCase{
@@ -126,7 +125,7 @@ func TestAccAzureRMContainerRegistry_basicStandard(t *testing.T) {
 }
 
 func TestAccAzureRMContainerRegistry_basicPremium(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMContainerRegistry_basicManaged(ri, testLocation(), "Premium")
 
 	resource.Test(t, resource.TestCase{
@@ -145,8 +144,8 @@ func TestAccAzureRMContainerRegistry_basicPremium(t *testing.T) {
 }
 
 func TestAccAzureRMContainerRegistry_complete(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	config := testAccAzureRMContainerRegistry_complete(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -165,8 +164,8 @@ func TestAccAzureRMContainerRegistry_complete(t *testing.T) {
 }
 
 func TestAccAzureRMContainerRegistry_update(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	location := testLocation()
 	config := testAccAzureRMContainerRegistry_complete(ri, rs, location)
 	updatedConfig := testAccAzureRMContainerRegistry_completeUpdated(ri, rs, location)
@@ -193,7 +192,7 @@ func TestAccAzureRMContainerRegistry_update(t *testing.T) {
 }
 
 func testCheckAzureRMContainerRegistryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).containerRegistryClient
+	conn := testAccProvider.Meta().(*ArmClient).containerRegistry().containerRegistryClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -231,7 +230,7 @@ func testCheckAzureRMContainerRegistryExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for Container Registry: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).containerRegistryClient
+		conn := testAccProvider.Meta().(*ArmClient).containerRegistry().containerRegistryClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
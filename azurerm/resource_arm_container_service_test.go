@@ -6,7 +6,6 @@ import (
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -77,7 +76,7 @@ func TestAccAzureRMContainerService_agentProfilePoolCountValidation(t *testing.T
 }
 
 func TestAccAzureRMContainerService_dcosBasic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMContainerService_dcosBasic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -96,7 +95,7 @@ func TestAccAzureRMContainerService_dcosBasic(t *testing.T) {
 }
 
 func TestAccAzureRMContainerService_kubernetesBasic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	clientId := os.Getenv("ARM_CLIENT_ID")
 	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
 	config := testAccAzureRMContainerService_kubernetesBasic(ri, clientId, clientSecret, testLocation())
@@ -117,7 +116,7 @@ func TestAccAzureRMContainerService_kubernetesBasic(t *testing.T) {
 }
 
 func TestAccAzureRMContainerService_kubernetesComplete(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	clientId := os.Getenv("ARM_CLIENT_ID")
 	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
 	config := testAccAzureRMContainerService_kubernetesComplete(ri, clientId, clientSecret, testLocation())
@@ -138,7 +137,7 @@ func TestAccAzureRMContainerService_kubernetesComplete(t *testing.T) {
 }
 
 func TestAccAzureRMContainerService_swarmBasic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMContainerService_swarmBasic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -344,7 +343,7 @@ func testCheckAzureRMContainerServiceExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for Container Service Instance: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).containerServicesClient
+		conn := testAccProvider.Meta().(*ArmClient).containerServices().containerServicesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
@@ -361,7 +360,7 @@ func testCheckAzureRMContainerServiceExists(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMContainerServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).containerServicesClient
+	conn := testAccProvider.Meta().(*ArmClient).containerServices().containerServicesClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_container_service" {
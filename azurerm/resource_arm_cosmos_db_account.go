@@ -4,23 +4,38 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var cosmosDBAccountIDFormat = resourceIDFormat{
+	description: "CosmosDB Account",
+	provider:    "Microsoft.DocumentDB",
+	segments: []resourceIDSegment{
+		{key: "databaseAccounts", placeholder: "accountName"},
+	},
+}
+
 func resourceArmCosmosDBAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmCosmosDBAccountCreateUpdate,
-		Read:   resourceArmCosmosDBAccountRead,
-		Update: resourceArmCosmosDBAccountCreateUpdate,
-		Delete: resourceArmCosmosDBAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmCosmosDBAccountCreateUpdate,
+		Read:     resourceArmCosmosDBAccountRead,
+		Update:   resourceArmCosmosDBAccountCreateUpdate,
+		Delete:   resourceArmCosmosDBAccountDelete,
+		Importer: resourceIDImporter(cosmosDBAccountIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -147,19 +162,34 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 				Computed: true,
 			},
 
+			"deletion_protection": deletionProtectionSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
 }
 
 func resourceArmCosmosDBAccountCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cosmosDBClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).cosmosDB().cosmosDBClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Cosmos DB Account creation.")
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
+
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, resGroup, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing CosmosDB Account %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_cosmosdb_account", *existing.ID)
+		}
+	}
+
 	kind := d.Get("kind").(string)
 	offerType := d.Get("offer_type").(string)
 	ipRangeFilter := d.Get("ip_range_filter").(string)
@@ -180,7 +210,7 @@ func resourceArmCosmosDBAccountCreateUpdate(d *schema.ResourceData, meta interfa
 			DatabaseAccountOfferType: utils.String(offerType),
 			IPRangeFilter:            utils.String(ipRangeFilter),
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
@@ -188,7 +218,7 @@ func resourceArmCosmosDBAccountCreateUpdate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	err = future.WaitForCompletion(ctx, client.Client)
+	err = waitForCreation(ctx, d, meta, future.Future, client.Client)
 	if err != nil {
 		return err
 	}
@@ -208,8 +238,9 @@ func resourceArmCosmosDBAccountCreateUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cosmosDBClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).cosmosDB().cosmosDBClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
@@ -252,14 +283,16 @@ func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("secondary_readonly_master_key", readonlyKeys.SecondaryReadonlyMasterKey)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	setDeletionProtection(d)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmCosmosDBAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).cosmosDBClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).cosmosDB().cosmosDBClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -268,6 +301,10 @@ func resourceArmCosmosDBAccountDelete(d *schema.ResourceData, meta interface{})
 	resGroup := id.ResourceGroup
 	name := id.Path["databaseAccounts"]
 
+	if err := checkDeletionProtection(d, "CosmosDB Account", name); err != nil {
+		return err
+	}
+
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
 		if response.WasNotFound(future.Response()) {
@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -24,7 +23,7 @@ func testSweepCosmosDBAccount(region string) error {
 		return err
 	}
 
-	client := (*armClient).cosmosDBClient
+	client := (*armClient).cosmosDB().cosmosDBClient
 	ctx := (*armClient).StopContext
 
 	log.Printf("Retrieving the CosmosDB Accounts..")
@@ -62,7 +61,7 @@ func testSweepCosmosDBAccount(region string) error {
 
 func TestAccAzureRMCosmosDBAccount_boundedStaleness(t *testing.T) {
 	resourceName := "azurerm_cosmosdb_account.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_boundedStaleness(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -83,7 +82,7 @@ func TestAccAzureRMCosmosDBAccount_boundedStaleness(t *testing.T) {
 
 func TestAccAzureRMCosmosDBAccount_boundedStalenessComplete(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_boundedStalenessComplete(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -102,7 +101,7 @@ func TestAccAzureRMCosmosDBAccount_boundedStalenessComplete(t *testing.T) {
 }
 
 func TestAccAzureRMCosmosDBAccount_eventualConsistency(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_eventualConsistency(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -122,7 +121,7 @@ func TestAccAzureRMCosmosDBAccount_eventualConsistency(t *testing.T) {
 
 func TestAccAzureRMCosmosDBAccount_mongoDB(t *testing.T) {
 	resourceName := "azurerm_cosmosdb_account.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_mongoDB(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -142,7 +141,7 @@ func TestAccAzureRMCosmosDBAccount_mongoDB(t *testing.T) {
 }
 
 func TestAccAzureRMCosmosDBAccount_session(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_session(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -161,7 +160,7 @@ func TestAccAzureRMCosmosDBAccount_session(t *testing.T) {
 }
 
 func TestAccAzureRMCosmosDBAccount_strong(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_strong(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -181,7 +180,7 @@ func TestAccAzureRMCosmosDBAccount_strong(t *testing.T) {
 
 func TestAccAzureRMCosmosDBAccount_geoReplicated(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMCosmosDBAccount_geoReplicated(ri, testLocation(), testAltLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -200,7 +199,7 @@ func TestAccAzureRMCosmosDBAccount_geoReplicated(t *testing.T) {
 }
 
 func testCheckAzureRMCosmosDBAccountDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).cosmosDBClient
+	conn := testAccProvider.Meta().(*ArmClient).cosmosDB().cosmosDBClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -239,7 +238,7 @@ func testCheckAzureRMCosmosDBAccountExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for CosmosDB Account: '%s'", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).cosmosDBClient
+		conn := testAccProvider.Meta().(*ArmClient).cosmosDB().cosmosDBClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, name)
@@ -5,7 +5,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -13,7 +12,7 @@ import (
 
 func TestAccAzureRMEventGridTopic_basic(t *testing.T) {
 	resourceName := "azurerm_eventgrid_topic.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMEventGridTopic_basic(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -36,7 +35,7 @@ func TestAccAzureRMEventGridTopic_basic(t *testing.T) {
 
 func TestAccAzureRMEventGridTopic_basicWithTags(t *testing.T) {
 	resourceName := "azurerm_eventgrid_topic.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMEventGridTopic_basicWithTags(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -60,7 +59,7 @@ func TestAccAzureRMEventGridTopic_basicWithTags(t *testing.T) {
 }
 
 func testCheckAzureRMEventGridTopicDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).eventGridTopicsClient
+	client := testAccProvider.Meta().(*ArmClient).eventGrid().eventGridTopicsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -102,7 +101,7 @@ func testCheckAzureRMEventGridTopicExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for EventGrid Topic: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).eventGridTopicsClient
+		client := testAccProvider.Meta().(*ArmClient).eventGrid().eventGridTopicsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
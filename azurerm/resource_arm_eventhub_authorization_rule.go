@@ -4,20 +4,37 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var eventHubAuthorizationRuleIDFormat = resourceIDFormat{
+	description: "EventHub Authorization Rule",
+	provider:    "Microsoft.EventHub",
+	segments: []resourceIDSegment{
+		{key: "namespaces", placeholder: "namespaceName"},
+		{key: "eventhubs", placeholder: "eventHubName"},
+		{key: "authorizationRules", placeholder: "authorizationRuleName"},
+	},
+}
+
 func resourceArmEventHubAuthorizationRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmEventHubAuthorizationRuleCreateUpdate,
-		Read:   resourceArmEventHubAuthorizationRuleRead,
-		Update: resourceArmEventHubAuthorizationRuleCreateUpdate,
-		Delete: resourceArmEventHubAuthorizationRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmEventHubAuthorizationRuleCreateUpdate,
+		Read:     resourceArmEventHubAuthorizationRuleRead,
+		Update:   resourceArmEventHubAuthorizationRuleCreateUpdate,
+		Delete:   resourceArmEventHubAuthorizationRuleDelete,
+		Importer: resourceIDImporter(eventHubAuthorizationRuleIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -85,8 +102,9 @@ func resourceArmEventHubAuthorizationRule() *schema.Resource {
 }
 
 func resourceArmEventHubAuthorizationRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).eventHubClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).eventHub().eventHubClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM EventHub Authorization Rule creation.")
 
 	name := d.Get("name").(string)
@@ -94,6 +112,17 @@ func resourceArmEventHubAuthorizationRuleCreateUpdate(d *schema.ResourceData, me
 	eventHubName := d.Get("eventhub_name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
+	if requiresImport(d, meta) {
+		existing, err := client.GetAuthorizationRule(ctx, resGroup, namespaceName, eventHubName, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing EventHub Authorization Rule %q (EventHub %q / Namespace %q / Resource Group %q): %+v", name, eventHubName, namespaceName, resGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_eventhub_authorization_rule", *existing.ID)
+		}
+	}
+
 	rights, err := expandEventHubAuthorizationRuleAccessRights(d)
 	if err != nil {
 		return err
@@ -126,8 +155,9 @@ func resourceArmEventHubAuthorizationRuleCreateUpdate(d *schema.ResourceData, me
 }
 
 func resourceArmEventHubAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).eventHubClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).eventHub().eventHubClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -168,8 +198,9 @@ func resourceArmEventHubAuthorizationRuleRead(d *schema.ResourceData, meta inter
 }
 
 func resourceArmEventHubAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	eventhubClient := meta.(*ArmClient).eventHubClient
-	ctx := meta.(*ArmClient).StopContext
+	eventhubClient := meta.(*ArmClient).eventHub().eventHubClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -7,7 +7,6 @@ import (
 
 	"strconv"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -166,7 +165,7 @@ func TestAccAzureRMEventHubArchiveNameFormat_validation(t *testing.T) {
 
 func TestAccAzureRMEventHub_basic(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMEventHub_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -186,7 +185,7 @@ func TestAccAzureRMEventHub_basic(t *testing.T) {
 
 func TestAccAzureRMEventHub_standard(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMEventHub_standard(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -206,8 +205,8 @@ func TestAccAzureRMEventHub_standard(t *testing.T) {
 
 func TestAccAzureRMEventHub_captureDescription(t *testing.T) {
 	resourceName := "azurerm_eventhub.test"
-	ri := acctest.RandInt()
-	rs := acctest.RandString(5)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 5)
 	config := testAccAzureRMEventHub_captureDescription(ri, rs, testLocation(), true)
 
 	resource.Test(t, resource.TestCase{
@@ -228,8 +227,8 @@ func TestAccAzureRMEventHub_captureDescription(t *testing.T) {
 
 func TestAccAzureRMEventHub_captureDescriptionDisabled(t *testing.T) {
 	resourceName := "azurerm_eventhub.test"
-	ri := acctest.RandInt()
-	rs := acctest.RandString(5)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 5)
 	location := testLocation()
 
 	config := testAccAzureRMEventHub_captureDescription(ri, rs, location, true)
@@ -259,7 +258,7 @@ func TestAccAzureRMEventHub_captureDescriptionDisabled(t *testing.T) {
 }
 
 func testCheckAzureRMEventHubDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).eventHubClient
+	conn := testAccProvider.Meta().(*ArmClient).eventHub().eventHubClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -300,7 +299,7 @@ func testCheckAzureRMEventHubExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Event Hub: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).eventHubClient
+		conn := testAccProvider.Meta().(*ArmClient).eventHub().eventHubClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, namespaceName, name)
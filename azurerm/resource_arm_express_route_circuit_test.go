@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -14,7 +13,7 @@ import (
 
 func TestAccAzureRMExpressRouteCircuit_basic(t *testing.T) {
 	var erc network.ExpressRouteCircuit
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -44,7 +43,7 @@ func testCheckAzureRMExpressRouteCircuitExists(name string, erc *network.Express
 			return fmt.Errorf("Bad: no resource group found in state for Express Route Circuit: %s", expressRouteCircuitName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).expressRouteCircuitClient
+		client := testAccProvider.Meta().(*ArmClient).networking().expressRouteCircuitClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, expressRouteCircuitName)
@@ -63,7 +62,7 @@ func testCheckAzureRMExpressRouteCircuitExists(name string, erc *network.Express
 }
 
 func testCheckAzureRMExpressRouteCircuitDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).expressRouteCircuitClient
+	client := testAccProvider.Meta().(*ArmClient).networking().expressRouteCircuitClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -5,7 +5,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -13,8 +12,8 @@ import (
 
 func TestAccAzureRMFunctionApp_basic(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
-	ri := acctest.RandInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	ri := testAccRandInt(t)
+	rs := strings.ToLower(testAccRandString(t, 11))
 	config := testAccAzureRMFunctionApp_basic(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -35,8 +34,8 @@ func TestAccAzureRMFunctionApp_basic(t *testing.T) {
 
 func TestAccAzureRMFunctionApp_tags(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
-	ri := acctest.RandInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	ri := testAccRandInt(t)
+	rs := strings.ToLower(testAccRandString(t, 11))
 	config := testAccAzureRMFunctionApp_tags(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -58,8 +57,8 @@ func TestAccAzureRMFunctionApp_tags(t *testing.T) {
 
 func TestAccAzureRMFunctionApp_appSettings(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
-	ri := acctest.RandInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	ri := testAccRandInt(t)
+	rs := strings.ToLower(testAccRandString(t, 11))
 	config := testAccAzureRMFunctionApp_basic(ri, rs, testLocation())
 	updatedConfig := testAccAzureRMFunctionApp_appSettings(ri, rs, testLocation())
 
@@ -96,8 +95,8 @@ func TestAccAzureRMFunctionApp_appSettings(t *testing.T) {
 
 func TestAccAzureRMFunctionApp_siteConfig(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
-	ri := acctest.RandInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	ri := testAccRandInt(t)
+	rs := strings.ToLower(testAccRandString(t, 11))
 	config := testAccAzureRMFunctionApp_alwaysOn(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -118,8 +117,8 @@ func TestAccAzureRMFunctionApp_siteConfig(t *testing.T) {
 
 func TestAccAzureRMFunctionApp_connectionStrings(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
-	ri := acctest.RandInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	ri := testAccRandInt(t)
+	rs := strings.ToLower(testAccRandString(t, 11))
 	config := testAccAzureRMFunctionApp_connectionStrings(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -142,8 +141,8 @@ func TestAccAzureRMFunctionApp_connectionStrings(t *testing.T) {
 
 func TestAccAzureRMFunctionApp_siteConfigMulti(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
-	ri := acctest.RandInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	ri := testAccRandInt(t)
+	rs := strings.ToLower(testAccRandString(t, 11))
 	configBase := testAccAzureRMFunctionApp_basic(ri, rs, testLocation())
 	configUpdate1 := testAccAzureRMFunctionApp_appSettings(ri, rs, testLocation())
 	configUpdate2 := testAccAzureRMFunctionApp_appSettingsAlwaysOn(ri, rs, testLocation())
@@ -196,8 +195,8 @@ func TestAccAzureRMFunctionApp_siteConfigMulti(t *testing.T) {
 
 func TestAccAzureRMFunctionApp_updateVersion(t *testing.T) {
 	resourceName := "azurerm_function_app.test"
-	ri := acctest.RandInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	ri := testAccRandInt(t)
+	rs := strings.ToLower(testAccRandString(t, 11))
 	preConfig := testAccAzureRMFunctionApp_version(ri, rs, testLocation(), "beta")
 	postConfig := testAccAzureRMFunctionApp_version(ri, rs, testLocation(), "~1")
 
@@ -225,7 +224,7 @@ func TestAccAzureRMFunctionApp_updateVersion(t *testing.T) {
 }
 
 func testCheckAzureRMFunctionAppDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).appServicesClient
+	client := testAccProvider.Meta().(*ArmClient).web().appServicesClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_function_app" {
@@ -264,7 +263,7 @@ func testCheckAzureRMFunctionAppExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Function App: %s", functionAppName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).appServicesClient
+		client := testAccProvider.Meta().(*ArmClient).web().appServicesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, functionAppName)
 		if err != nil {
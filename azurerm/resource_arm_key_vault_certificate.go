@@ -2,21 +2,27 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmKeyVaultCertificate() *schema.Resource {
 	return &schema.Resource{
 		// TODO: support Updating once we have more information about what can be updated
-		Create: resourceArmKeyVaultCertificateCreate,
-		Read:   resourceArmKeyVaultCertificateRead,
-		Delete: resourceArmKeyVaultCertificateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmKeyVaultCertificateCreate,
+		Read:     resourceArmKeyVaultCertificateRead,
+		Delete:   resourceArmKeyVaultCertificateDelete,
+		Importer: keyVaultChildImporter("certificates"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -228,11 +234,25 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 }
 
 func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).keyVault().keyVaultManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	keyVaultBaseUrl := d.Get("vault_uri").(string)
+
+	if requiresImport(d, meta) {
+		// "" indicates the latest version
+		existing, err := client.GetCertificate(ctx, keyVaultBaseUrl, name, "")
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Certificate %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_key_vault_certificate", *existing.ID)
+		}
+	}
+
 	tags := d.Get("tags").(map[string]interface{})
 
 	policy := expandKeyVaultCertificatePolicy(d)
@@ -244,7 +264,7 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 			Base64EncodedCertificate: utils.String(certificate.CertificateData),
 			Password:                 utils.String(certificate.CertificatePassword),
 			CertificatePolicy:        &policy,
-			Tags:                     expandTags(tags),
+			Tags:                     expandTags(tags, meta),
 		}
 		_, err := client.ImportCertificate(ctx, keyVaultBaseUrl, name, importParameters)
 		if err != nil {
@@ -254,7 +274,7 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 		// Generate new
 		parameters := keyvault.CertificateCreateParameters{
 			CertificatePolicy: &policy,
-			Tags:              expandTags(tags),
+			Tags:              expandTags(tags, meta),
 		}
 		_, err := client.CreateCertificate(ctx, keyVaultBaseUrl, name, parameters)
 		if err != nil {
@@ -273,8 +293,9 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).keyVault().keyVaultManagementClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -302,14 +323,15 @@ func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}
 
 	// Computed
 	d.Set("version", id.Version)
-	flattenAndSetTags(d, cert.Tags)
+	flattenAndSetTags(d, cert.Tags, meta)
 
 	return nil
 }
 
 func resourceArmKeyVaultCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).keyVault().keyVaultManagementClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseKeyVaultChildID(d.Id())
 	if err != nil {
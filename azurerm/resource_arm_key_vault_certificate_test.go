@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -12,7 +11,7 @@ import (
 
 func TestAccAzureRMKeyVaultCertificate_basicImportPFX(t *testing.T) {
 	resourceName := "azurerm_key_vault_certificate.test"
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultCertificate_basicImportPFX(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -32,7 +31,7 @@ func TestAccAzureRMKeyVaultCertificate_basicImportPFX(t *testing.T) {
 
 func TestAccAzureRMKeyVaultCertificate_basicGenerate(t *testing.T) {
 	resourceName := "azurerm_key_vault_certificate.test"
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultCertificate_basicGenerate(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -52,7 +51,7 @@ func TestAccAzureRMKeyVaultCertificate_basicGenerate(t *testing.T) {
 
 func TestAccAzureRMKeyVaultCertificate_basicGenerateTags(t *testing.T) {
 	resourceName := "azurerm_key_vault_certificate.test"
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultCertificate_basicGenerateTags(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -73,7 +72,7 @@ func TestAccAzureRMKeyVaultCertificate_basicGenerateTags(t *testing.T) {
 }
 
 func testCheckAzureRMKeyVaultCertificateDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+	client := testAccProvider.Meta().(*ArmClient).keyVault().keyVaultManagementClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -109,7 +108,7 @@ func testCheckAzureRMKeyVaultCertificateExists(name string) resource.TestCheckFu
 		name := rs.Primary.Attributes["name"]
 		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
 
-		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+		client := testAccProvider.Meta().(*ArmClient).keyVault().keyVaultManagementClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.GetCertificate(ctx, vaultBaseUrl, name, "")
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -12,7 +11,7 @@ import (
 
 func TestAccAzureRMKeyVaultKey_basicEC(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultKey_basicEC(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -32,7 +31,7 @@ func TestAccAzureRMKeyVaultKey_basicEC(t *testing.T) {
 
 func TestAccAzureRMKeyVaultKey_basicRSA(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultKey_basicRSA(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -52,7 +51,7 @@ func TestAccAzureRMKeyVaultKey_basicRSA(t *testing.T) {
 
 func TestAccAzureRMKeyVaultKey_basicRSAHSM(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultKey_basicRSAHSM(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -72,7 +71,7 @@ func TestAccAzureRMKeyVaultKey_basicRSAHSM(t *testing.T) {
 
 func TestAccAzureRMKeyVaultKey_complete(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultKey_complete(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -94,7 +93,7 @@ func TestAccAzureRMKeyVaultKey_complete(t *testing.T) {
 
 func TestAccAzureRMKeyVaultKey_update(t *testing.T) {
 	resourceName := "azurerm_key_vault_key.test"
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultKey_basicRSA(rs, testLocation())
 	updatedConfig := testAccAzureRMKeyVaultKey_basicUpdated(rs, testLocation())
 
@@ -124,7 +123,7 @@ func TestAccAzureRMKeyVaultKey_update(t *testing.T) {
 }
 
 func testCheckAzureRMKeyVaultKeyDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+	client := testAccProvider.Meta().(*ArmClient).keyVault().keyVaultManagementClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -160,7 +159,7 @@ func testCheckAzureRMKeyVaultKeyExists(name string) resource.TestCheckFunc {
 		name := rs.Primary.Attributes["name"]
 		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
 
-		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+		client := testAccProvider.Meta().(*ArmClient).keyVault().keyVaultManagementClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.GetKey(ctx, vaultBaseUrl, name, "")
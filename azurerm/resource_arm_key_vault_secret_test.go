@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -12,7 +11,7 @@ import (
 
 func TestAccAzureRMKeyVaultSecret_basic(t *testing.T) {
 	resourceName := "azurerm_key_vault_secret.test"
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultSecret_basic(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -33,7 +32,7 @@ func TestAccAzureRMKeyVaultSecret_basic(t *testing.T) {
 
 func TestAccAzureRMKeyVaultSecret_complete(t *testing.T) {
 	resourceName := "azurerm_key_vault_secret.test"
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultSecret_complete(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -55,7 +54,7 @@ func TestAccAzureRMKeyVaultSecret_complete(t *testing.T) {
 
 func TestAccAzureRMKeyVaultSecret_update(t *testing.T) {
 	resourceName := "azurerm_key_vault_secret.test"
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMKeyVaultSecret_basic(rs, testLocation())
 	updatedConfig := testAccAzureRMKeyVaultSecret_basicUpdated(rs, testLocation())
 
@@ -83,7 +82,7 @@ func TestAccAzureRMKeyVaultSecret_update(t *testing.T) {
 }
 
 func testCheckAzureRMKeyVaultSecretDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+	client := testAccProvider.Meta().(*ArmClient).keyVault().keyVaultManagementClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -119,7 +118,7 @@ func testCheckAzureRMKeyVaultSecretExists(name string) resource.TestCheckFunc {
 		name := rs.Primary.Attributes["name"]
 		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
 
-		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+		client := testAccProvider.Meta().(*ArmClient).keyVault().keyVaultManagementClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.GetSecret(ctx, vaultBaseUrl, name, "")
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -69,7 +68,7 @@ func TestAccAzureRMKeyVault_name(t *testing.T) {
 }
 
 func TestAccAzureRMKeyVault_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMKeyVault_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -89,7 +88,7 @@ func TestAccAzureRMKeyVault_basic(t *testing.T) {
 
 func TestAccAzureRMKeyVault_complete(t *testing.T) {
 	resourceName := "azurerm_key_vault.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMKeyVault_complete(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -109,7 +108,7 @@ func TestAccAzureRMKeyVault_complete(t *testing.T) {
 }
 
 func TestAccAzureRMKeyVault_update(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	resourceName := "azurerm_key_vault.test"
 	preConfig := testAccAzureRMKeyVault_basic(ri, testLocation())
 	postConfig := testAccAzureRMKeyVault_update(ri, testLocation())
@@ -144,7 +143,7 @@ func TestAccAzureRMKeyVault_update(t *testing.T) {
 }
 
 func testCheckAzureRMKeyVaultDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+	client := testAccProvider.Meta().(*ArmClient).keyVault().keyVaultClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -183,7 +182,7 @@ func testCheckAzureRMKeyVaultExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for vault: %s", vaultName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+		client := testAccProvider.Meta().(*ArmClient).keyVault().keyVaultClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, vaultName)
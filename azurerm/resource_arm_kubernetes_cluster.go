@@ -4,22 +4,37 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2017-09-30/containerservice"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var kubernetesClusterIDFormat = resourceIDFormat{
+	description: "Kubernetes Cluster",
+	provider:    "Microsoft.ContainerService",
+	segments: []resourceIDSegment{
+		{key: "managedClusters", placeholder: "clusterName"},
+	},
+}
+
 func resourceArmKubernetesCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmKubernetesClusterCreate,
-		Read:   resourceArmKubernetesClusterRead,
-		Update: resourceArmKubernetesClusterCreate,
-		Delete: resourceArmKubernetesClusterDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmKubernetesClusterCreate,
+		Read:     resourceArmKubernetesClusterRead,
+		Update:   resourceArmKubernetesClusterCreate,
+		Delete:   resourceArmKubernetesClusterDelete,
+		Importer: resourceIDImporter(kubernetesClusterIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -113,9 +128,10 @@ func resourceArmKubernetesCluster() *schema.Resource {
 						},
 
 						"vnet_subnet_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateSubnetID,
 						},
 
 						"os_type": {
@@ -161,7 +177,7 @@ func resourceArmKubernetesCluster() *schema.Resource {
 
 func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	kubernetesClustersClient := client.kubernetesClustersClient
+	kubernetesClustersClient := client.containerServices().kubernetesClustersClient
 
 	log.Printf("[INFO] preparing arguments for Azure ARM AKS managed cluster creation.")
 
@@ -187,16 +203,29 @@ func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}
 			LinuxProfile:            &linuxProfile,
 			ServicePrincipalProfile: servicePrincipalProfile,
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
+	}
+
+	ctx, cancel := timeouts.ForCreateUpdate(client.StopContext, d)
+	defer cancel()
+
+	if requiresImport(d, meta) {
+		existing, err := kubernetesClustersClient.Get(ctx, resGroup, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_kubernetes_cluster", *existing.ID)
+		}
 	}
 
-	ctx := client.StopContext
 	future, err := kubernetesClustersClient.CreateOrUpdate(ctx, resGroup, name, parameters)
 	if err != nil {
 		return err
 	}
 
-	err = future.WaitForCompletion(ctx, kubernetesClustersClient.Client)
+	err = waitForCreation(ctx, d, meta, future.Future, kubernetesClustersClient.Client)
 	if err != nil {
 		return err
 	}
@@ -217,7 +246,7 @@ func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}
 
 func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	kubernetesClustersClient := meta.(*ArmClient).kubernetesClustersClient
+	kubernetesClustersClient := meta.(*ArmClient).containerServices().kubernetesClustersClient
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -226,7 +255,8 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 	resGroup := id.ResourceGroup
 	name := id.Path["managedClusters"]
 
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
 	resp, err := kubernetesClustersClient.Get(ctx, resGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -260,14 +290,14 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		d.Set("service_principal", servicePrincipal)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	kubernetesClustersClient := client.kubernetesClustersClient
+	kubernetesClustersClient := client.containerServices().kubernetesClustersClient
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -276,7 +306,8 @@ func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}
 	resGroup := id.ResourceGroup
 	name := id.Path["managedClusters"]
 
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
 	future, err := kubernetesClustersClient.Delete(ctx, resGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error issuing AzureRM delete request of AKS Managed Cluster %q (resource Group %q): %+v", name, resGroup, err)
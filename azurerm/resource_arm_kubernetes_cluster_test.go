@@ -6,13 +6,12 @@ import (
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAzureRMKubernetesCluster_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	clientId := os.Getenv("ARM_CLIENT_ID")
 	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
 	config := testAccAzureRMKubernetesCluster_basic(ri, clientId, clientSecret, testLocation())
@@ -33,7 +32,7 @@ func TestAccAzureRMKubernetesCluster_basic(t *testing.T) {
 }
 
 func TestAccAzureRMKubernetesCluster_addAgent(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	clientId := os.Getenv("ARM_CLIENT_ID")
 	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
 	initConfig := testAccAzureRMKubernetesCluster_basic(ri, clientId, clientSecret, testLocation())
@@ -61,7 +60,7 @@ func TestAccAzureRMKubernetesCluster_addAgent(t *testing.T) {
 }
 
 func TestAccAzureRMKubernetesCluster_upgradeConfig(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	clientId := os.Getenv("ARM_CLIENT_ID")
 	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
 	initConfig := testAccAzureRMKubernetesCluster_basic(ri, clientId, clientSecret, testLocation())
@@ -89,7 +88,7 @@ func TestAccAzureRMKubernetesCluster_upgradeConfig(t *testing.T) {
 }
 
 func TestAccAzureRMKubernetesCluster_internalNetwork(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	clientId := os.Getenv("ARM_CLIENT_ID")
 	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
 	config := testAccAzureRMKubernetesCluster_internalNetwork(ri, clientId, clientSecret, testLocation())
@@ -286,7 +285,7 @@ func testCheckAzureRMKubernetesClusterExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for AKS managed cluster instance: %s", resourceName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).kubernetesClustersClient
+		client := testAccProvider.Meta().(*ArmClient).containerServices().kubernetesClustersClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		aks, err := client.Get(ctx, resourceGroup, resourceName)
@@ -303,7 +302,7 @@ func testCheckAzureRMKubernetesClusterExists(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMKubernetesClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).kubernetesClustersClient
+	conn := testAccProvider.Meta().(*ArmClient).containerServices().kubernetesClustersClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_kubernetes_cluster" {
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
@@ -14,7 +13,7 @@ import (
 func TestAccAzureRMLocalNetworkGateway_basic(t *testing.T) {
 	name := "azurerm_local_network_gateway.test"
 
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -34,7 +33,7 @@ func TestAccAzureRMLocalNetworkGateway_basic(t *testing.T) {
 
 func TestAccAzureRMLocalNetworkGateway_disappears(t *testing.T) {
 	name := "azurerm_local_network_gateway.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -58,7 +57,7 @@ func TestAccAzureRMLocalNetworkGateway_disappears(t *testing.T) {
 func TestAccAzureRMLocalNetworkGateway_tags(t *testing.T) {
 	resourceName := "azurerm_local_network_gateway.test"
 
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -78,7 +77,7 @@ func TestAccAzureRMLocalNetworkGateway_tags(t *testing.T) {
 
 func TestAccAzureRMLocalNetworkGateway_bgpSettings(t *testing.T) {
 	name := "azurerm_local_network_gateway.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -101,7 +100,7 @@ func TestAccAzureRMLocalNetworkGateway_bgpSettings(t *testing.T) {
 
 func TestAccAzureRMLocalNetworkGateway_bgpSettingsDisable(t *testing.T) {
 	name := "azurerm_local_network_gateway.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -135,7 +134,7 @@ func TestAccAzureRMLocalNetworkGateway_bgpSettingsDisable(t *testing.T) {
 
 func TestAccAzureRMLocalNetworkGateway_bgpSettingsEnable(t *testing.T) {
 	name := "azurerm_local_network_gateway.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -169,7 +168,7 @@ func TestAccAzureRMLocalNetworkGateway_bgpSettingsEnable(t *testing.T) {
 
 func TestAccAzureRMLocalNetworkGateway_bgpSettingsComplete(t *testing.T) {
 	name := "azurerm_local_network_gateway.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	location := testLocation()
 
 	resource.Test(t, resource.TestCase{
@@ -213,7 +212,7 @@ func testCheckAzureRMLocalNetworkGatewayExists(name string) resource.TestCheckFu
 		resGrp := id.ResourceGroup
 
 		// and finally, check that it exists on Azure:
-		client := testAccProvider.Meta().(*ArmClient).localNetConnClient
+		client := testAccProvider.Meta().(*ArmClient).networking().localNetConnClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resGrp, localNetName)
@@ -246,7 +245,7 @@ func testCheckAzureRMLocalNetworkGatewayDisappears(name string) resource.TestChe
 		resourceGroup := id.ResourceGroup
 
 		// and finally, check that it exists on Azure:
-		client := testAccProvider.Meta().(*ArmClient).localNetConnClient
+		client := testAccProvider.Meta().(*ArmClient).networking().localNetConnClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		future, err := client.Delete(ctx, resourceGroup, localNetName)
@@ -279,7 +278,7 @@ func testCheckAzureRMLocalNetworkGatewayDestroy(s *terraform.State) error {
 		localNetName := id.Path["localNetworkGateways"]
 		resourceGroup := id.ResourceGroup
 
-		client := testAccProvider.Meta().(*ArmClient).localNetConnClient
+		client := testAccProvider.Meta().(*ArmClient).networking().localNetConnClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, localNetName)
 
@@ -6,14 +6,13 @@ import (
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAzureRMManagedDisk_empty(t *testing.T) {
 	var d compute.Disk
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMManagedDisk_empty(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -32,7 +31,7 @@ func TestAccAzureRMManagedDisk_empty(t *testing.T) {
 
 func TestAccAzureRMManagedDisk_zeroGbFromPlatformImage(t *testing.T) {
 	var d compute.Disk
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMManagedDisk_zeroGbFromPlatformImage(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -52,7 +51,7 @@ func TestAccAzureRMManagedDisk_zeroGbFromPlatformImage(t *testing.T) {
 func TestAccAzureRMManagedDisk_import(t *testing.T) {
 	var d compute.Disk
 	var vm compute.VirtualMachine
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	vmConfig := testAccAzureRMVirtualMachine_basicLinuxMachine(ri, location)
 	config := testAccAzureRMManagedDisk_import(ri, location)
@@ -83,7 +82,7 @@ func TestAccAzureRMManagedDisk_import(t *testing.T) {
 
 func TestAccAzureRMManagedDisk_copy(t *testing.T) {
 	var d compute.Disk
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMManagedDisk_copy(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -102,7 +101,7 @@ func TestAccAzureRMManagedDisk_copy(t *testing.T) {
 
 func TestAccAzureRMManagedDisk_fromPlatformImage(t *testing.T) {
 	var d compute.Disk
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMManagedDisk_platformImage(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -123,7 +122,7 @@ func TestAccAzureRMManagedDisk_update(t *testing.T) {
 	var d compute.Disk
 
 	resourceName := "azurerm_managed_disk.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	preConfig := testAccAzureRMManagedDisk_empty(ri, testLocation())
 	postConfig := testAccAzureRMManagedDisk_empty_updated(ri, testLocation())
 	resource.Test(t, resource.TestCase{
@@ -160,8 +159,8 @@ func TestAccAzureRMManagedDisk_encryption(t *testing.T) {
 	var d compute.Disk
 
 	resourceName := "azurerm_managed_disk.test"
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	preConfig := testAccAzureRMManagedDisk_encryption(ri, rs, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -188,7 +187,7 @@ func TestAccAzureRMManagedDisk_encryption(t *testing.T) {
 
 func TestAccAzureRMManagedDisk_NonStandardCasing(t *testing.T) {
 	var d compute.Disk
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMManagedDiskNonStandardCasing(ri, testLocation())
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -223,7 +222,7 @@ func testCheckAzureRMManagedDiskExists(name string, d *compute.Disk, shouldExist
 			return fmt.Errorf("Bad: no resource group found in state for disk: %s", dName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).diskClient
+		client := testAccProvider.Meta().(*ArmClient).compute().diskClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, dName)
@@ -245,7 +244,7 @@ func testCheckAzureRMManagedDiskExists(name string, d *compute.Disk, shouldExist
 }
 
 func testCheckAzureRMManagedDiskDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).diskClient
+	client := testAccProvider.Meta().(*ArmClient).compute().diskClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -283,7 +282,7 @@ func testDeleteAzureRMVirtualMachine(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for virtual machine: %s", vmName)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).vmClient
+		client := testAccProvider.Meta().(*ArmClient).compute().vmClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		future, err := client.Delete(ctx, resourceGroup, vmName)
@@ -5,20 +5,32 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var managementLockIDFormat = scopedResourceIDFormat{
+	description: "Management Lock",
+	provider:    "Microsoft.Authorization",
+	segment:     resourceIDSegment{key: "locks", placeholder: "lockName"},
+}
+
 func resourceArmManagementLock() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmManagementLockCreateUpdate,
-		Read:   resourceArmManagementLockRead,
-		Delete: resourceArmManagementLockDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmManagementLockCreateUpdate,
+		Read:     resourceArmManagementLockRead,
+		Delete:   resourceArmManagementLockDelete,
+		Importer: scopedResourceIDImporter(managementLockIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -56,12 +68,25 @@ func resourceArmManagementLock() *schema.Resource {
 }
 
 func resourceArmManagementLockCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).managementLocksClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).resources().managementLocksClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Management Lock creation.")
 
 	name := d.Get("name").(string)
 	scope := d.Get("scope").(string)
+
+	if requiresImport(d, meta) {
+		existing, err := client.GetByScope(ctx, scope, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Management Lock %q (Scope %q): %+v", name, scope, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_management_lock", *existing.ID)
+		}
+	}
+
 	lockLevel := d.Get("lock_level").(string)
 	notes := d.Get("notes").(string)
 
@@ -91,8 +116,9 @@ func resourceArmManagementLockCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmManagementLockRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).managementLocksClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).resources().managementLocksClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureRMLockId(d.Id())
 	if err != nil {
@@ -120,8 +146,9 @@ func resourceArmManagementLockRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmManagementLockDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).managementLocksClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).resources().managementLocksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureRMLockId(d.Id())
 	if err != nil {
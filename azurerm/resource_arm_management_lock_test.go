@@ -41,7 +41,7 @@ func TestValidateManagementLockName(t *testing.T) {
 
 func TestAccAzureRMManagementLock_resourceGroupReadOnlyBasic(t *testing.T) {
 	resourceName := "azurerm_management_lock.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMManagementLock_resourceGroupReadOnlyBasic(ri, location)
 
@@ -62,7 +62,7 @@ func TestAccAzureRMManagementLock_resourceGroupReadOnlyBasic(t *testing.T) {
 
 func TestAccAzureRMManagementLock_resourceGroupReadOnlyComplete(t *testing.T) {
 	resourceName := "azurerm_management_lock.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMManagementLock_resourceGroupReadOnlyComplete(ri, location)
 
@@ -83,7 +83,7 @@ func TestAccAzureRMManagementLock_resourceGroupReadOnlyComplete(t *testing.T) {
 
 func TestAccAzureRMManagementLock_resourceGroupCanNotDeleteBasic(t *testing.T) {
 	resourceName := "azurerm_management_lock.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMManagementLock_resourceGroupCanNotDeleteBasic(ri, location)
 
@@ -104,7 +104,7 @@ func TestAccAzureRMManagementLock_resourceGroupCanNotDeleteBasic(t *testing.T) {
 
 func TestAccAzureRMManagementLock_resourceGroupCanNotDeleteComplete(t *testing.T) {
 	resourceName := "azurerm_management_lock.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMManagementLock_resourceGroupCanNotDeleteComplete(ri, location)
 
@@ -125,7 +125,7 @@ func TestAccAzureRMManagementLock_resourceGroupCanNotDeleteComplete(t *testing.T
 
 func TestAccAzureRMManagementLock_publicIPReadOnlyBasic(t *testing.T) {
 	resourceName := "azurerm_management_lock.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMManagementLock_publicIPReadOnlyBasic(ri, location)
 
@@ -146,7 +146,7 @@ func TestAccAzureRMManagementLock_publicIPReadOnlyBasic(t *testing.T) {
 
 func TestAccAzureRMManagementLock_publicIPCanNotDeleteBasic(t *testing.T) {
 	resourceName := "azurerm_management_lock.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMManagementLock_publicIPCanNotDeleteBasic(ri, location)
 
@@ -172,7 +172,7 @@ func TestAccAzureRMManagementLock_subscriptionReadOnlyBasic(t *testing.T) {
 	}
 
 	resourceName := "azurerm_management_lock.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMManagementLock_subscriptionReadOnlyBasic(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -197,7 +197,7 @@ func TestAccAzureRMManagementLock_subscriptionCanNotDeleteBasic(t *testing.T) {
 	}
 
 	resourceName := "azurerm_management_lock.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMManagementLock_subscriptionCanNotDeleteBasic(ri)
 
 	resource.Test(t, resource.TestCase{
@@ -226,7 +226,7 @@ func testCheckAzureRMManagementLockExists(resourceName string) resource.TestChec
 		name := rs.Primary.Attributes["name"]
 		scope := rs.Primary.Attributes["scope"]
 
-		client := testAccProvider.Meta().(*ArmClient).managementLocksClient
+		client := testAccProvider.Meta().(*ArmClient).resources().managementLocksClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.GetByScope(ctx, scope, name)
@@ -243,7 +243,7 @@ func testCheckAzureRMManagementLockExists(resourceName string) resource.TestChec
 }
 
 func testCheckAzureRMManagementLockDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).managementLocksClient
+	client := testAccProvider.Meta().(*ArmClient).resources().managementLocksClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
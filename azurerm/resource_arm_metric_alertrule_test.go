@@ -5,7 +5,6 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -13,7 +12,7 @@ import (
 
 func TestAccAzureRMMetricAlertRule_virtualMachineCpu(t *testing.T) {
 	resourceName := "azurerm_metric_alertrule.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	preConfig := testAccAzureRMMetricAlertRule_virtualMachineCpu(ri, testLocation(), true)
 	postConfig := testAccAzureRMMetricAlertRule_virtualMachineCpu(ri, testLocation(), false)
 
@@ -42,7 +41,7 @@ func TestAccAzureRMMetricAlertRule_virtualMachineCpu(t *testing.T) {
 
 func TestAccAzureRMMetricAlertRule_sqlDatabaseStorage(t *testing.T) {
 	resourceName := "azurerm_metric_alertrule.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMMetricAlertRule_sqlDatabaseStorage(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -74,7 +73,7 @@ func testCheckAzureRMMetricAlertRuleExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Alert Rule: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).monitorAlertRulesClient
+		client := testAccProvider.Meta().(*ArmClient).monitor().monitorAlertRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -91,7 +90,7 @@ func testCheckAzureRMMetricAlertRuleExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMMetricAlertRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).monitorAlertRulesClient
+	client := testAccProvider.Meta().(*ArmClient).monitor().monitorAlertRulesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMMySQLConfiguration_characterSetServer(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMMySQLConfiguration_characterSetServer(ri, location)
 	serverOnlyConfig := testAccAzureRMMySQLConfiguration_empty(ri, location)
@@ -39,7 +38,7 @@ func TestAccAzureRMMySQLConfiguration_characterSetServer(t *testing.T) {
 }
 
 func TestAccAzureRMMySQLConfiguration_interactiveTimeout(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMMySQLConfiguration_interactiveTimeout(ri, location)
 	serverOnlyConfig := testAccAzureRMMySQLConfiguration_empty(ri, location)
@@ -68,7 +67,7 @@ func TestAccAzureRMMySQLConfiguration_interactiveTimeout(t *testing.T) {
 
 func TestAccAzureRMMySQLConfiguration_logSlowAdminStatements(t *testing.T) {
 	resourceName := "azurerm_mysql_configuration.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMMySQLConfiguration_logSlowAdminStatements(ri, location)
 	serverOnlyConfig := testAccAzureRMMySQLConfiguration_empty(ri, location)
@@ -110,7 +109,7 @@ func testCheckAzureRMMySQLConfigurationValue(resourceName string, value string)
 			return fmt.Errorf("Bad: no resource group found in state for MySQL Configuration: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).mysqlConfigurationsClient
+		client := testAccProvider.Meta().(*ArmClient).databases().mysqlConfigurationsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, name)
@@ -136,7 +135,7 @@ func testCheckAzureRMMySQLConfigurationValueReset(rInt int, configurationName st
 		resourceGroup := fmt.Sprintf("acctestRG-%d", rInt)
 		serverName := fmt.Sprintf("acctestmysqlsvr-%d", rInt)
 
-		client := testAccProvider.Meta().(*ArmClient).mysqlConfigurationsClient
+		client := testAccProvider.Meta().(*ArmClient).databases().mysqlConfigurationsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, configurationName)
@@ -159,7 +158,7 @@ func testCheckAzureRMMySQLConfigurationValueReset(rInt int, configurationName st
 }
 
 func testCheckAzureRMMySQLConfigurationDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).mysqlConfigurationsClient
+	client := testAccProvider.Meta().(*ArmClient).databases().mysqlConfigurationsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -12,7 +11,7 @@ import (
 
 func TestAccAzureRMMySQLFirewallRule_basic(t *testing.T) {
 	resourceName := "azurerm_mysql_firewall_rule.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMMySQLFirewallRule_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -45,7 +44,7 @@ func testCheckAzureRMMySQLFirewallRuleExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for MySQL Firewall Rule: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).mysqlFirewallRulesClient
+		client := testAccProvider.Meta().(*ArmClient).databases().mysqlFirewallRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, name)
@@ -61,7 +60,7 @@ func testCheckAzureRMMySQLFirewallRuleExists(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMMySQLFirewallRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).mysqlDatabasesClient
+	client := testAccProvider.Meta().(*ArmClient).databases().mysqlDatabasesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
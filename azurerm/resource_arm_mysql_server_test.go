@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -12,7 +11,7 @@ import (
 
 func TestAccAzureRMMySQLServer_basicFiveSix(t *testing.T) {
 	resourceName := "azurerm_mysql_server.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMMySQLServer_basicFiveSix(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -32,7 +31,7 @@ func TestAccAzureRMMySQLServer_basicFiveSix(t *testing.T) {
 
 func TestAccAzureRMMySQLServer_basicFiveSeven(t *testing.T) {
 	resourceName := "azurerm_mysql_server.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMMySQLServer_basicFiveSeven(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -52,7 +51,7 @@ func TestAccAzureRMMySQLServer_basicFiveSeven(t *testing.T) {
 
 func TestAccAzureRMMySqlServer_standard(t *testing.T) {
 	resourceName := "azurerm_mysql_server.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMMySQLServer_standard(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -84,7 +83,7 @@ func testCheckAzureRMMySQLServerExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for MySQL Server: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).mysqlServersClient
+		client := testAccProvider.Meta().(*ArmClient).databases().mysqlServersClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -101,7 +100,7 @@ func testCheckAzureRMMySQLServerExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMMySQLServerDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).mysqlServersClient
+	client := testAccProvider.Meta().(*ArmClient).databases().mysqlServersClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -6,7 +6,6 @@ import (
 
 	"log"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
@@ -30,7 +29,7 @@ func testSweepNetworkInterfaces(region string) error {
 		return err
 	}
 
-	client := (*armClient).ifaceClient
+	client := (*armClient).networking().ifaceClient
 	ctx := (*armClient).StopContext
 
 	log.Printf("Retrieving the Network Interfaces..")
@@ -77,7 +76,7 @@ func testSweepNetworkInterfaces(region string) error {
 }
 
 func TestAccAzureRMNetworkInterface_basic(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -95,7 +94,7 @@ func TestAccAzureRMNetworkInterface_basic(t *testing.T) {
 
 func TestAccAzureRMNetworkInterface_disappears(t *testing.T) {
 	resourceName := "azurerm_network_interface.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -115,7 +114,7 @@ func TestAccAzureRMNetworkInterface_disappears(t *testing.T) {
 
 func TestAccAzureRMNetworkInterface_setNetworkSecurityGroupId(t *testing.T) {
 	resourceName := "azurerm_network_interface.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMNetworkInterface_basic(rInt, location)
 	updatedConfig := testAccAzureRMNetworkInterface_basicWithNetworkSecurityGroup(rInt, location)
@@ -144,7 +143,7 @@ func TestAccAzureRMNetworkInterface_setNetworkSecurityGroupId(t *testing.T) {
 
 func TestAccAzureRMNetworkInterface_removeNetworkSecurityGroupId(t *testing.T) {
 	resourceName := "azurerm_network_interface.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMNetworkInterface_basicWithNetworkSecurityGroup(rInt, location)
 	updatedConfig := testAccAzureRMNetworkInterface_basic(rInt, location)
@@ -173,7 +172,7 @@ func TestAccAzureRMNetworkInterface_removeNetworkSecurityGroupId(t *testing.T) {
 
 func TestAccAzureRMNetworkInterface_multipleSubnets(t *testing.T) {
 	resourceName := "azurerm_network_interface.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMNetworkInterface_multipleSubnets(rInt, location)
 
@@ -195,7 +194,7 @@ func TestAccAzureRMNetworkInterface_multipleSubnets(t *testing.T) {
 
 func TestAccAzureRMNetworkInterface_multipleSubnetsPrimary(t *testing.T) {
 	resourceName := "azurerm_network_interface.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMNetworkInterface_multipleSubnets(rInt, location)
 	updatedConfig := testAccAzureRMNetworkInterface_multipleSubnetsUpdatedPrimary(rInt, location)
@@ -231,7 +230,7 @@ func TestAccAzureRMNetworkInterface_multipleSubnetsPrimary(t *testing.T) {
 
 func TestAccAzureRMNetworkInterface_enableIPForwarding(t *testing.T) {
 	resourceName := "azurerm_network_interface.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -250,7 +249,7 @@ func TestAccAzureRMNetworkInterface_enableIPForwarding(t *testing.T) {
 
 func TestAccAzureRMNetworkInterface_enableAcceleratedNetworking(t *testing.T) {
 	resourceName := "azurerm_network_interface.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -268,7 +267,7 @@ func TestAccAzureRMNetworkInterface_enableAcceleratedNetworking(t *testing.T) {
 }
 
 func TestAccAzureRMNetworkInterface_multipleLoadBalancers(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -287,7 +286,7 @@ func TestAccAzureRMNetworkInterface_multipleLoadBalancers(t *testing.T) {
 
 func TestAccAzureRMNetworkInterface_withTags(t *testing.T) {
 	resourceName := "azurerm_network_interface.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -315,7 +314,7 @@ func TestAccAzureRMNetworkInterface_withTags(t *testing.T) {
 }
 
 func TestAccAzureRMNetworkInterface_bug7986(t *testing.T) {
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -346,7 +345,7 @@ func testCheckAzureRMNetworkInterfaceExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).ifaceClient
+		client := testAccProvider.Meta().(*ArmClient).networking().ifaceClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name, "")
@@ -376,7 +375,7 @@ func testCheckAzureRMNetworkInterfaceDisappears(name string) resource.TestCheckF
 			return fmt.Errorf("Bad: no resource group found in state for availability set: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).ifaceClient
+		client := testAccProvider.Meta().(*ArmClient).networking().ifaceClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		future, err := client.Delete(ctx, resourceGroup, name)
@@ -394,7 +393,7 @@ func testCheckAzureRMNetworkInterfaceDisappears(name string) resource.TestCheckF
 }
 
 func testCheckAzureRMNetworkInterfaceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).ifaceClient
+	client := testAccProvider.Meta().(*ArmClient).networking().ifaceClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -2,21 +2,36 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var networkWatcherIDFormat = resourceIDFormat{
+	description: "Network Watcher",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "networkWatchers", placeholder: "networkWatcherName"},
+	},
+}
+
 func resourceArmNetworkWatcher() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmNetworkWatcherCreateUpdate,
-		Read:   resourceArmNetworkWatcherRead,
-		Update: resourceArmNetworkWatcherCreateUpdate,
-		Delete: resourceArmNetworkWatcherDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmNetworkWatcherCreateUpdate,
+		Read:     resourceArmNetworkWatcherRead,
+		Update:   resourceArmNetworkWatcherCreateUpdate,
+		Delete:   resourceArmNetworkWatcherDelete,
+		Importer: resourceIDImporter(networkWatcherIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -36,17 +51,30 @@ func resourceArmNetworkWatcher() *schema.Resource {
 }
 
 func resourceArmNetworkWatcherCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).watcherClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).networking().watcherClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Network Watcher %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_network_watcher", *existing.ID)
+		}
+	}
+
 	location := d.Get("location").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
 	watcher := network.Watcher{
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 	}
 	_, err := client.CreateOrUpdate(ctx, resourceGroup, name, watcher)
 	if err != nil {
@@ -67,8 +95,9 @@ func resourceArmNetworkWatcherCreateUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmNetworkWatcherRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).watcherClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).networking().watcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -90,14 +119,15 @@ func resourceArmNetworkWatcherRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("resource_group_name", resourceGroup)
 	d.Set("location", azureRMNormalizeLocation(*resp.Location))
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmNetworkWatcherDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).watcherClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).networking().watcherClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
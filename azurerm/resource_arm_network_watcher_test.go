@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
@@ -13,7 +12,7 @@ import (
 
 func TestAccAzureRMNetworkWatcher_basic(t *testing.T) {
 	resourceGroup := "azurerm_network_watcher.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -31,7 +30,7 @@ func TestAccAzureRMNetworkWatcher_basic(t *testing.T) {
 
 func TestAccAzureRMNetworkWatcher_complete(t *testing.T) {
 	resourceGroup := "azurerm_network_watcher.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -49,7 +48,7 @@ func TestAccAzureRMNetworkWatcher_complete(t *testing.T) {
 
 func TestAccAzureRMNetworkWatcher_update(t *testing.T) {
 	resourceGroup := "azurerm_network_watcher.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -73,7 +72,7 @@ func TestAccAzureRMNetworkWatcher_update(t *testing.T) {
 
 func TestAccAzureRMNetworkWatcher_disappears(t *testing.T) {
 	resourceGroup := "azurerm_network_watcher.test"
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -106,7 +105,7 @@ func testCheckAzureRMNetworkWatcherExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Network Watcher: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).watcherClient
+		client := testAccProvider.Meta().(*ArmClient).networking().watcherClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -135,7 +134,7 @@ func testCheckAzureRMNetworkWatcherDisappears(name string) resource.TestCheckFun
 			return fmt.Errorf("Bad: no resource group found in state for Network Watcher: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).watcherClient
+		client := testAccProvider.Meta().(*ArmClient).networking().watcherClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		future, err := client.Delete(ctx, resourceGroup, name)
 		if err != nil {
@@ -163,7 +162,7 @@ func testCheckAzureRMNetworkWatcherDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).watcherClient
+		client := testAccProvider.Meta().(*ArmClient).networking().watcherClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 
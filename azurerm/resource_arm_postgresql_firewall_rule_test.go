@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -12,7 +11,7 @@ import (
 
 func TestAccAzureRMPostgreSQLFirewallRule_basic(t *testing.T) {
 	resourceName := "azurerm_postgresql_firewall_rule.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMPostgreSQLFirewallRule_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -47,7 +46,7 @@ func testCheckAzureRMPostgreSQLFirewallRuleExists(name string) resource.TestChec
 			return fmt.Errorf("Bad: no resource group found in state for PostgreSQL Firewall Rule: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).postgresqlFirewallRulesClient
+		client := testAccProvider.Meta().(*ArmClient).databases().postgresqlFirewallRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, name)
@@ -64,7 +63,7 @@ func testCheckAzureRMPostgreSQLFirewallRuleExists(name string) resource.TestChec
 }
 
 func testCheckAzureRMPostgreSQLFirewallRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).postgresqlDatabasesClient
+	client := testAccProvider.Meta().(*ArmClient).databases().postgresqlDatabasesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
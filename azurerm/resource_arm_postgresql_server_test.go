@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -12,7 +11,7 @@ import (
 
 func TestAccAzureRMPostgreSQLServer_basicNinePointFive(t *testing.T) {
 	resourceName := "azurerm_postgresql_server.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMPostgreSQLServer_basicNinePointFive(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -36,7 +35,7 @@ func TestAccAzureRMPostgreSQLServer_basicNinePointFive(t *testing.T) {
 
 func TestAccAzureRMPostgreSQLServer_basicNinePointSix(t *testing.T) {
 	resourceName := "azurerm_postgresql_server.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMPostgreSQLServer_basicNinePointSix(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -60,7 +59,7 @@ func TestAccAzureRMPostgreSQLServer_basicNinePointSix(t *testing.T) {
 
 func TestAccAzureRMPostgreSQLServer_basicMaxStorage(t *testing.T) {
 	resourceName := "azurerm_postgresql_server.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMPostgreSQLServer_basicMaxStorage(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -84,7 +83,7 @@ func TestAccAzureRMPostgreSQLServer_basicMaxStorage(t *testing.T) {
 
 func TestAccAzureRMPostgreSQLServer_standard(t *testing.T) {
 	resourceName := "azurerm_postgresql_server.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMPostgreSQLServer_standard(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -104,7 +103,7 @@ func TestAccAzureRMPostgreSQLServer_standard(t *testing.T) {
 
 func TestAccAzureRMPostgreSQLServer_updatePassword(t *testing.T) {
 	resourceName := "azurerm_postgresql_server.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMPostgreSQLServer_basicNinePointSix(ri, location)
 	updatedConfig := testAccAzureRMPostgreSQLServer_basicNinePointSixUpdated(ri, location)
@@ -144,7 +143,7 @@ func testCheckAzureRMPostgreSQLServerExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Bad: no resource group found in state for PostgreSQL Server: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).postgresqlServersClient
+		client := testAccProvider.Meta().(*ArmClient).databases().postgresqlServersClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -161,7 +160,7 @@ func testCheckAzureRMPostgreSQLServerExists(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMPostgreSQLServerDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).postgresqlServersClient
+	client := testAccProvider.Meta().(*ArmClient).databases().postgresqlServersClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
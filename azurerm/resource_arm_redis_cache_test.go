@@ -5,7 +5,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -104,7 +103,7 @@ func TestAccAzureRMRedisCacheBackupFrequency_validation(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRedisCache_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -123,7 +122,7 @@ func TestAccAzureRMRedisCache_basic(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_standard(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRedisCache_standard(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -142,7 +141,7 @@ func TestAccAzureRMRedisCache_standard(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_premium(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRedisCache_premium(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -161,7 +160,7 @@ func TestAccAzureRMRedisCache_premium(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_premiumSharded(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRedisCache_premiumSharded(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -180,7 +179,7 @@ func TestAccAzureRMRedisCache_premiumSharded(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_NonStandardCasing(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRedisCacheNonStandardCasing(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -205,7 +204,7 @@ func TestAccAzureRMRedisCache_NonStandardCasing(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_BackupDisabled(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRedisCacheBackupDisabled(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -224,8 +223,8 @@ func TestAccAzureRMRedisCache_BackupDisabled(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_BackupEnabled(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	config := testAccAzureRMRedisCacheBackupEnabled(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -244,8 +243,8 @@ func TestAccAzureRMRedisCache_BackupEnabled(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_BackupEnabledDisabled(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	location := testLocation()
 	config := testAccAzureRMRedisCacheBackupEnabled(ri, rs, location)
 	updatedConfig := testAccAzureRMRedisCacheBackupDisabled(ri, location)
@@ -272,7 +271,7 @@ func TestAccAzureRMRedisCache_BackupEnabledDisabled(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_PatchSchedule(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMRedisCachePatchSchedule(ri, location)
 
@@ -292,7 +291,7 @@ func TestAccAzureRMRedisCache_PatchSchedule(t *testing.T) {
 }
 
 func TestAccAzureRMRedisCache_PatchScheduleUpdated(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	config := testAccAzureRMRedisCachePatchSchedule(ri, location)
 	updatedConfig := testAccAzureRMRedisCache_premium(ri, location)
@@ -332,7 +331,7 @@ func testCheckAzureRMRedisCacheExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Redis Instance: %s", redisName)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).redisClient
+		conn := testAccProvider.Meta().(*ArmClient).redis().redisClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := conn.Get(ctx, resourceGroup, redisName)
@@ -349,7 +348,7 @@ func testCheckAzureRMRedisCacheExists(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMRedisCacheDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).redisClient
+	conn := testAccProvider.Meta().(*ArmClient).redis().redisClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
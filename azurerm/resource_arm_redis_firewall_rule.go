@@ -3,20 +3,38 @@ package azurerm
 import (
 	"fmt"
 	"log"
-
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2016-04-01/redis"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var redisFirewallRuleIDFormat = resourceIDFormat{
+	description: "Redis Firewall Rule",
+	provider:    "Microsoft.Cache",
+	segments: []resourceIDSegment{
+		{key: "Redis", placeholder: "cacheName"},
+		{key: "firewallRules", placeholder: "firewallRuleName"},
+	},
+}
+
 func resourceArmRedisFirewallRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmRedisFirewallRuleCreateUpdate,
-		Read:   resourceArmRedisFirewallRuleRead,
-		Update: resourceArmRedisFirewallRuleCreateUpdate,
-		Delete: resourceArmRedisFirewallRuleDelete,
+		Create:   resourceArmRedisFirewallRuleCreateUpdate,
+		Read:     resourceArmRedisFirewallRuleRead,
+		Update:   resourceArmRedisFirewallRuleCreateUpdate,
+		Delete:   resourceArmRedisFirewallRuleDelete,
+		Importer: resourceIDImporter(redisFirewallRuleIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -48,13 +66,26 @@ func resourceArmRedisFirewallRule() *schema.Resource {
 }
 
 func resourceArmRedisFirewallRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).redisFirewallClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).redis().redisFirewallClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Redis Firewall Rule creation.")
 
 	name := d.Get("name").(string)
 	cacheName := d.Get("redis_cache_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, resourceGroup, cacheName, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Redis Firewall Rule %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_redis_firewall_rule", *existing.ID)
+		}
+	}
+
 	startIP := d.Get("start_ip").(string)
 	endIP := d.Get("end_ip").(string)
 
@@ -85,8 +116,9 @@ func resourceArmRedisFirewallRuleCreateUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceArmRedisFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).redisFirewallClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).redis().redisFirewallClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -120,8 +152,9 @@ func resourceArmRedisFirewallRuleRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceArmRedisFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).redisFirewallClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).redis().redisFirewallClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -5,7 +5,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -53,7 +52,7 @@ func TestAzureRMRedisFirewallRuleName_validation(t *testing.T) {
 
 func TestAccAzureRMRedisFirewallRule_basic(t *testing.T) {
 	resourceName := "azurerm_redis_firewall_rule.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRedisFirewallRule_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -73,7 +72,7 @@ func TestAccAzureRMRedisFirewallRule_basic(t *testing.T) {
 
 func TestAccAzureRMRedisFirewallRule_update(t *testing.T) {
 	resourceName := "azurerm_redis_firewall_rule.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRedisFirewallRule_basic(ri, testLocation())
 	updatedConfig := testAccAzureRMRedisFirewallRule_update(ri, testLocation())
 
@@ -110,7 +109,7 @@ func testCheckAzureRMRedisFirewallRuleExists(name string) resource.TestCheckFunc
 		cacheName := rs.Primary.Attributes["redis_cache_name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).redisFirewallClient
+		client := testAccProvider.Meta().(*ArmClient).redis().redisFirewallClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, cacheName, name)
 		if err != nil {
@@ -125,7 +124,7 @@ func testCheckAzureRMRedisFirewallRuleExists(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMRedisFirewallRuleDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).resourceGroupsClient
+	client := testAccProvider.Meta().(*ArmClient).resources().resourceGroupsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -0,0 +1,507 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// genericResourceReadOnlyProperties are the top-level properties Azure returns for every resource, which aren't
+// part of the body of the resource when it's imported
+var genericResourceReadOnlyProperties = []string{"id", "name", "type", "etag"}
+
+func resourceArmResource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmResourceCreateUpdate,
+		Read:   resourceArmResourceRead,
+		Update: resourceArmResourceCreateUpdate,
+		Delete: resourceArmResourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmResourceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"parent_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateResourceID,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateGenericResourceType,
+			},
+
+			"api_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateGenericResourceAPIVersion,
+			},
+
+			"body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				StateFunc:    normalizeJson,
+				ValidateFunc: validation.ValidateJsonString,
+			},
+
+			"response_export_values": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmResourceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resources().resourcesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := genericResourceID(d.Get("parent_id").(string), d.Get("type").(string), d.Get("name").(string))
+	if err != nil {
+		return err
+	}
+	apiVersion := d.Get("api_version").(string)
+
+	if requiresImport(d, meta) {
+		existing, _, err := readGenericResource(ctx, client, id, apiVersion)
+		if err != nil && !utils.ResponseWasNotFound(existing) {
+			return fmt.Errorf("Error checking for presence of existing Resource %q: %+v", id, err)
+		}
+
+		if !utils.ResponseWasNotFound(existing) {
+			return importAsExistsError("azurerm_resource", id)
+		}
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("body").(string)), &body); err != nil {
+		return fmt.Errorf("Error parsing `body` for Resource %q - it must be a JSON object: %+v", id, err)
+	}
+
+	req, err := prepareGenericResourceRequest(ctx, client, id, apiVersion, autorest.AsJSON(), autorest.AsPut(), autorest.WithJSON(body))
+	if err != nil {
+		return fmt.Errorf("Error preparing the request to create/update Resource %q: %+v", id, err)
+	}
+
+	future, err := client.CreateOrUpdateByIDSender(req)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Resource %q: %+v", id, err)
+	}
+
+	if err := waitForCreation(ctx, d, meta, future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Resource %q: %+v", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmResourceRead(d, meta)
+}
+
+func resourceArmResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resources().resourcesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id := d.Id()
+	resp, result, err := readGenericResource(ctx, client, id, d.Get("api_version").(string))
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Resource %q was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Resource %q: %+v", id, err)
+	}
+
+	parentId, resourceType, name, err := parseGenericResourceID(id)
+	if err != nil {
+		return err
+	}
+	d.Set("parent_id", parentId)
+	d.Set("type", resourceType)
+	d.Set("name", name)
+
+	// only the properties which are specified in the configuration are compared, since Azure returns read-only
+	// (and default) values in addition to them
+	var body interface{}
+	if v := d.Get("body").(string); v != "" {
+		var configured interface{}
+		if err := json.Unmarshal([]byte(v), &configured); err != nil {
+			return fmt.Errorf("Error parsing `body` for Resource %q: %+v", id, err)
+		}
+		body = projectGenericResourceBody(configured, result)
+	} else {
+		for _, property := range genericResourceReadOnlyProperties {
+			delete(result, property)
+		}
+		body = result
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("Error serializing `body` for Resource %q: %+v", id, err)
+	}
+	d.Set("body", normalizeJson(string(encoded)))
+
+	outputs := make(map[string]string)
+	for _, v := range d.Get("response_export_values").([]interface{}) {
+		path := v.(string)
+		value, ok := genericResourceValue(result, path)
+		if !ok {
+			log.Printf("[DEBUG] %q wasn't returned for Resource %q - skipping", path, id)
+			continue
+		}
+
+		if s, ok := value.(string); ok {
+			outputs[path] = s
+			continue
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("Error serializing %q for Resource %q: %+v", path, id, err)
+		}
+		outputs[path] = string(encoded)
+	}
+
+	return d.Set("outputs", outputs)
+}
+
+func resourceArmResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resources().resourcesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id := d.Id()
+	req, err := prepareGenericResourceRequest(ctx, client, id, d.Get("api_version").(string), autorest.AsDelete())
+	if err != nil {
+		return fmt.Errorf("Error preparing the request to delete Resource %q: %+v", id, err)
+	}
+
+	future, err := client.DeleteByIDSender(req)
+	if err != nil {
+		if response := future.Response(); response != nil && response.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Resource %q: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletion(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Resource %q: %+v", id, err)
+	}
+
+	return nil
+}
+
+// resourceArmResourceImport populates the parent, type and name of the resource from its ID - along with the
+// latest (non-preview) API version of the resource type, since this can't be determined from the ID
+func resourceArmResourceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*ArmClient)
+	ctx := client.StopContext
+
+	parentId, resourceType, name, err := parseGenericResourceID(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Error importing %q: %+v", d.Id(), err)
+	}
+
+	segments := strings.SplitN(resourceType, "/", 2)
+	provider, err := client.resources().providersClient.Get(ctx, segments[0], "")
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the Resource Provider %q to determine the API version for %q: %+v", segments[0], d.Id(), err)
+	}
+
+	apiVersion := latestGenericResourceAPIVersion(provider, segments[1])
+	if apiVersion == "" {
+		return nil, fmt.Errorf("Error importing %q: the Resource Provider %q doesn't publish an API version for %q", d.Id(), segments[0], segments[1])
+	}
+
+	d.Set("parent_id", parentId)
+	d.Set("type", resourceType)
+	d.Set("name", name)
+	d.Set("api_version", apiVersion)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// prepareGenericResourceRequest prepares a request for the resource using the specified API version, since the
+// `*ByID` methods in the SDK use the API version of the Resources API rather than that of the resource type
+func prepareGenericResourceRequest(ctx context.Context, client resources.Client, id, apiVersion string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"resourceId": strings.TrimPrefix(id, "/"),
+	}
+	queryParameters := map[string]interface{}{
+		"api-version": apiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{resourceId}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func readGenericResource(ctx context.Context, client resources.Client, id, apiVersion string) (autorest.Response, map[string]interface{}, error) {
+	req, err := prepareGenericResourceRequest(ctx, client, id, apiVersion, autorest.AsGet())
+	if err != nil {
+		return autorest.Response{}, nil, err
+	}
+
+	resp, err := client.GetByIDSender(req)
+	if err != nil {
+		return autorest.Response{Response: resp}, nil, err
+	}
+
+	result := make(map[string]interface{})
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	return autorest.Response{Response: resp}, result, err
+}
+
+// genericResourceID returns the ID of a resource of the specified type within the parent - which is either nested
+// within the parent (e.g. a Subnet within a Virtual Network), or a top-level or extension resource of the parent
+// (e.g. a Virtual Network within a Resource Group, or a Management Lock on a resource).
+func genericResourceID(parentId, resourceType, name string) (string, error) {
+	if _, err := url.ParseRequestURI(parentId); err != nil {
+		return "", fmt.Errorf("Cannot parse the Parent ID %q: %+v", parentId, err)
+	}
+	parentId = strings.TrimSuffix(parentId, "/")
+
+	if parentType := genericResourceParentType(parentId); parentType != "" && strings.HasPrefix(strings.ToLower(resourceType), strings.ToLower(parentType)+"/") {
+		childType := resourceType[len(parentType)+1:]
+		if !strings.Contains(childType, "/") {
+			return fmt.Sprintf("%s/%s/%s", parentId, childType, name), nil
+		}
+	}
+
+	return fmt.Sprintf("%s/providers/%s/%s", parentId, resourceType, name), nil
+}
+
+// parseGenericResourceID parses the ID of a resource into the ID of its parent, its type and its name
+func parseGenericResourceID(id string) (parentId string, resourceType string, name string, err error) {
+	if _, err := url.ParseRequestURI(id); err != nil {
+		return "", "", "", fmt.Errorf("Cannot parse %q as a Resource ID: %+v", id, err)
+	}
+
+	id = strings.TrimSuffix(id, "/")
+	index := strings.LastIndex(strings.ToLower(id), "/providers/")
+	if index == -1 {
+		return "", "", "", fmt.Errorf("Expected %q to be a Resource ID in the format `{parentId}/providers/{namespace}/{type}/{name}`", id)
+	}
+
+	segments := strings.Split(id[index+len("/providers/"):], "/")
+	if len(segments) < 3 || len(segments)%2 != 1 {
+		return "", "", "", fmt.Errorf("Expected %q to be a Resource ID in the format `{parentId}/providers/{namespace}/{type}/{name}`", id)
+	}
+
+	types := []string{segments[0]}
+	for i := 1; i < len(segments); i += 2 {
+		if segments[i] == "" || segments[i+1] == "" {
+			return "", "", "", fmt.Errorf("Expected %q to be a Resource ID in the format `{parentId}/providers/{namespace}/{type}/{name}`", id)
+		}
+		types = append(types, segments[i])
+	}
+
+	name = segments[len(segments)-1]
+	resourceType = strings.Join(types, "/")
+
+	// a nested resource's parent is the resource it's nested within, otherwise it's the scope before `/providers/`
+	if len(segments) > 3 {
+		parentId = strings.TrimSuffix(id, fmt.Sprintf("/%s/%s", segments[len(segments)-2], name))
+	} else {
+		parentId = id[:index]
+	}
+
+	return parentId, resourceType, name, nil
+}
+
+// genericResourceParentType returns the type of the parent resource (e.g. `Microsoft.Network/virtualNetworks`),
+// or an empty string when the parent is a Subscription or Resource Group
+func genericResourceParentType(parentId string) string {
+	_, resourceType, _, err := parseGenericResourceID(parentId)
+	if err != nil {
+		return ""
+	}
+
+	return resourceType
+}
+
+// projectGenericResourceBody returns the values from the response for each of the properties in the configured
+// body. Properties which aren't returned (such as secrets) keep their configured value, since they can't be
+// compared.
+func projectGenericResourceBody(configured interface{}, response interface{}) interface{} {
+	switch c := configured.(type) {
+	case map[string]interface{}:
+		r, ok := response.(map[string]interface{})
+		if !ok {
+			return response
+		}
+
+		result := make(map[string]interface{})
+		for key, value := range c {
+			actual, ok := r[key]
+			if !ok {
+				result[key] = value
+				continue
+			}
+
+			// Azure returns the location in its normalised form, e.g. `westeurope` for `West Europe`
+			if key == "location" {
+				if s, ok := value.(string); ok {
+					if a, ok := actual.(string); ok && azureRMNormalizeLocation(s) == azureRMNormalizeLocation(a) {
+						result[key] = value
+						continue
+					}
+				}
+			}
+
+			result[key] = projectGenericResourceBody(value, actual)
+		}
+		return result
+
+	case []interface{}:
+		r, ok := response.([]interface{})
+		if !ok || len(r) != len(c) {
+			return response
+		}
+
+		result := make([]interface{}, 0, len(c))
+		for i := range c {
+			result = append(result, projectGenericResourceBody(c[i], r[i]))
+		}
+		return result
+	}
+
+	return response
+}
+
+// genericResourceValue returns the value at the path (e.g. `properties.provisioningState`) within the resource
+func genericResourceValue(resource map[string]interface{}, path string) (interface{}, bool) {
+	var value interface{} = resource
+	for _, key := range strings.Split(path, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		value, ok = m[key]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// latestGenericResourceAPIVersion returns the latest API version of the resource type, preferring those which
+// aren't a preview
+func latestGenericResourceAPIVersion(provider resources.Provider, resourceType string) string {
+	if provider.ResourceTypes == nil {
+		return ""
+	}
+
+	for _, t := range *provider.ResourceTypes {
+		if t.ResourceType == nil || !strings.EqualFold(*t.ResourceType, resourceType) || t.APIVersions == nil {
+			continue
+		}
+
+		latest := ""
+		for _, version := range *t.APIVersions {
+			if strings.Contains(strings.ToLower(version), "preview") {
+				continue
+			}
+			if version > latest {
+				latest = version
+			}
+		}
+
+		if latest == "" && len(*t.APIVersions) > 0 {
+			latest = (*t.APIVersions)[0]
+		}
+		return latest
+	}
+
+	return ""
+}
+
+func validateGenericResourceType(i interface{}, k string) (ws []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	segments := strings.Split(v, "/")
+	if len(segments) < 2 {
+		es = append(es, fmt.Errorf("%s must be in the format `{namespace}/{type}` (e.g. `Microsoft.Network/virtualNetworks`), got %q", k, v))
+		return
+	}
+
+	for _, segment := range segments {
+		if segment == "" {
+			es = append(es, fmt.Errorf("%s must be in the format `{namespace}/{type}` (e.g. `Microsoft.Network/virtualNetworks`), got %q", k, v))
+			return
+		}
+	}
+
+	return
+}
+
+func validateGenericResourceAPIVersion(i interface{}, k string) (ws []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}(-[a-zA-Z0-9]+)?$`).MatchString(v) {
+		es = append(es, fmt.Errorf("%s must be an API version such as `2018-01-01` or `2018-01-01-preview`, got %q", k, v))
+	}
+
+	return
+}
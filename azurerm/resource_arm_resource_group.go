@@ -1,24 +1,42 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var resourceGroupIDFormat = resourceIDFormat{
+	description: "Resource Group",
+}
+
+// resourceGroupIsEmptyTimeout is how long a Resource Group which contains Resources is checked again for, before
+// refusing to delete it - since the Resources listed within it lag behind those which have recently been deleted
+var resourceGroupIsEmptyTimeout = 3 * time.Minute
+
 func resourceArmResourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmResourceGroupCreateUpdate,
-		Read:   resourceArmResourceGroupRead,
-		Update: resourceArmResourceGroupCreateUpdate,
-		Exists: resourceArmResourceGroupExists,
-		Delete: resourceArmResourceGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmResourceGroupCreateUpdate,
+		Read:     resourceArmResourceGroupRead,
+		Update:   resourceArmResourceGroupCreateUpdate,
+		Exists:   resourceArmResourceGroupExists,
+		Delete:   resourceArmResourceGroupDelete,
+		Importer: resourceIDImporter(resourceGroupIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -32,15 +50,28 @@ func resourceArmResourceGroup() *schema.Resource {
 }
 
 func resourceArmResourceGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).resourceGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).resources().resourceGroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
+
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Resource Group %q: %+v", name, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_resource_group", *existing.ID)
+		}
+	}
+
 	location := d.Get("location").(string)
 	tags := d.Get("tags").(map[string]interface{})
 	parameters := resources.Group{
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 	}
 	_, err := client.CreateOrUpdate(ctx, name, parameters)
 	if err != nil {
@@ -58,8 +89,9 @@ func resourceArmResourceGroupCreateUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).resourceGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).resources().resourceGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -81,14 +113,15 @@ func resourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 
 	d.Set("name", resp.Name)
 	d.Set("location", azureRMNormalizeLocation(*resp.Location))
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmResourceGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(*ArmClient).resourceGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).resources().resourceGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -110,8 +143,9 @@ func resourceArmResourceGroupExists(d *schema.ResourceData, meta interface{}) (b
 }
 
 func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).resourceGroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).resources().resourceGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -120,6 +154,12 @@ func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 	name := id.ResourceGroup
 
+	if meta.(*ArmClient).preventResourceGroupDeletionIfContainsResources {
+		if err := checkResourceGroupIsEmpty(ctx, meta, name); err != nil {
+			return err
+		}
+	}
+
 	deleteFuture, err := client.Delete(ctx, name)
 	if err != nil {
 		if response.WasNotFound(deleteFuture.Response()) {
@@ -140,3 +180,39 @@ func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 	return nil
 }
+
+// checkResourceGroupIsEmpty returns an error if the Resource Group contains any resources - since the resources
+// within it which are managed by Terraform have been deleted by this point, these aren't tracked in the State
+// and would otherwise be deleted along with the Resource Group. The resources which Terraform has just deleted
+// can still be listed for a short while, as such the Resource Group is checked again until it's empty (or
+// `resourceGroupIsEmptyTimeout` has passed).
+func checkResourceGroupIsEmpty(ctx context.Context, meta interface{}, name string) error {
+	client := meta.(*ArmClient).resources().resourcesClient
+
+	return resource.Retry(resourceGroupIsEmptyTimeout, func() *resource.RetryError {
+		iterator, err := client.ListByResourceGroupComplete(ctx, name, "", "", nil)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("Error listing the Resources within Resource Group %q: %+v", name, err))
+		}
+
+		ids := make([]string, 0)
+		for iterator.NotDone() {
+			if id := iterator.Value().ID; id != nil {
+				ids = append(ids, *id)
+			}
+
+			if err := iterator.Next(); err != nil {
+				return resource.NonRetryableError(fmt.Errorf("Error listing the Resources within Resource Group %q: %+v", name, err))
+			}
+		}
+
+		if len(ids) > 0 {
+			log.Printf("[DEBUG] Resource Group %q still contains %d Resource(s) - checking again", name, len(ids))
+			return resource.RetryableError(fmt.Errorf("Unable to delete Resource Group %q since it contains %d Resource(s) which aren't managed by Terraform:\n\n%s\n\n"+
+				"Either delete (or import) these Resources, or set `prevent_resource_group_deletion_if_contains_resources` to `false` in the Provider block to delete them along with the Resource Group.",
+				name, len(ids), strings.Join(ids, "\n")))
+		}
+
+		return nil
+	})
+}
@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -25,7 +24,7 @@ func testSweepResourceGroups(region string) error {
 		return err
 	}
 
-	client := (*armClient).resourceGroupsClient
+	client := (*armClient).resources().resourceGroupsClient
 	ctx := (*armClient).StopContext
 
 	log.Printf("Retrieving the Resource Groups..")
@@ -56,7 +55,7 @@ func testSweepResourceGroups(region string) error {
 }
 
 func TestAccAzureRMResourceGroup_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMResourceGroup_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -76,7 +75,7 @@ func TestAccAzureRMResourceGroup_basic(t *testing.T) {
 
 func TestAccAzureRMResourceGroup_disappears(t *testing.T) {
 	resourceName := "azurerm_resource_group.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMResourceGroup_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -98,7 +97,7 @@ func TestAccAzureRMResourceGroup_disappears(t *testing.T) {
 
 func TestAccAzureRMResourceGroup_withTags(t *testing.T) {
 	resourceName := "azurerm_resource_group.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	preConfig := testAccAzureRMResourceGroup_withTags(ri, location)
 	postConfig := testAccAzureRMResourceGroup_withTagsUpdated(ri, location)
@@ -140,7 +139,7 @@ func testCheckAzureRMResourceGroupExists(name string) resource.TestCheckFunc {
 		resourceGroup := rs.Primary.Attributes["name"]
 
 		// Ensure resource group exists in API
-		client := testAccProvider.Meta().(*ArmClient).resourceGroupsClient
+		client := testAccProvider.Meta().(*ArmClient).resources().resourceGroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup)
@@ -167,7 +166,7 @@ func testCheckAzureRMResourceGroupDisappears(name string) resource.TestCheckFunc
 		resourceGroup := rs.Primary.Attributes["name"]
 
 		// Ensure resource group exists in API
-		client := testAccProvider.Meta().(*ArmClient).resourceGroupsClient
+		client := testAccProvider.Meta().(*ArmClient).resources().resourceGroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		deleteFuture, err := client.Delete(ctx, resourceGroup)
@@ -185,7 +184,7 @@ func testCheckAzureRMResourceGroupDisappears(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMResourceGroupDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).resourceGroupsClient
+	client := testAccProvider.Meta().(*ArmClient).resources().resourceGroupsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -0,0 +1,323 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestGenericResourceID(t *testing.T) {
+	resourceGroupId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources"
+	networkId := resourceGroupId + "/providers/Microsoft.Network/virtualNetworks/example-network"
+
+	cases := []struct {
+		Name         string
+		ParentId     string
+		ResourceType string
+		ResourceName string
+		Expected     string
+	}{
+		{
+			Name:         "Within A Resource Group",
+			ParentId:     resourceGroupId,
+			ResourceType: "Microsoft.Network/virtualNetworks",
+			ResourceName: "example-network",
+			Expected:     networkId,
+		},
+		{
+			Name:         "Nested Within A Resource",
+			ParentId:     networkId,
+			ResourceType: "Microsoft.Network/virtualNetworks/subnets",
+			ResourceName: "internal",
+			Expected:     networkId + "/subnets/internal",
+		},
+		{
+			Name:         "Extension Of A Resource",
+			ParentId:     networkId,
+			ResourceType: "Microsoft.Authorization/locks",
+			ResourceName: "example-lock",
+			Expected:     networkId + "/providers/Microsoft.Authorization/locks/example-lock",
+		},
+		{
+			Name:         "Trailing Slash On The Parent",
+			ParentId:     resourceGroupId + "/",
+			ResourceType: "Microsoft.Network/virtualNetworks",
+			ResourceName: "example-network",
+			Expected:     networkId,
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := genericResourceID(tc.ParentId, tc.ResourceType, tc.ResourceName)
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Name, err)
+		}
+
+		if id != tc.Expected {
+			t.Fatalf("Expected the ID for %q to be %q but got %q", tc.Name, tc.Expected, id)
+		}
+
+		parentId, resourceType, name, err := parseGenericResourceID(id)
+		if err != nil {
+			t.Fatalf("Expected no error parsing the ID for %q but got: %+v", tc.Name, err)
+		}
+
+		if expected := tc.ParentId; parentId != expected && parentId+"/" != expected {
+			t.Fatalf("Expected the Parent ID for %q to be %q but got %q", tc.Name, expected, parentId)
+		}
+		if resourceType != tc.ResourceType {
+			t.Fatalf("Expected the type for %q to be %q but got %q", tc.Name, tc.ResourceType, resourceType)
+		}
+		if name != tc.ResourceName {
+			t.Fatalf("Expected the name for %q to be %q but got %q", tc.Name, tc.ResourceName, name)
+		}
+	}
+}
+
+func TestParseGenericResourceID_invalid(t *testing.T) {
+	cases := []string{
+		"",
+		"example",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Network",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Network/virtualNetworks",
+	}
+
+	for _, id := range cases {
+		if _, _, _, err := parseGenericResourceID(id); err == nil {
+			t.Fatalf("Expected an error parsing %q but didn't get one", id)
+		}
+	}
+}
+
+func TestProjectGenericResourceBody(t *testing.T) {
+	configured := `{
+  "location": "West Europe",
+  "properties": {
+    "addressSpace": { "addressPrefixes": ["10.0.0.0/16"] },
+    "secret": "hunter2"
+  }
+}`
+	response := `{
+  "id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Network/virtualNetworks/example-network",
+  "location": "westeurope",
+  "properties": {
+    "addressSpace": { "addressPrefixes": ["10.1.0.0/16"] },
+    "provisioningState": "Succeeded"
+  }
+}`
+	expected := `{
+  "location": "West Europe",
+  "properties": {
+    "addressSpace": { "addressPrefixes": ["10.1.0.0/16"] },
+    "secret": "hunter2"
+  }
+}`
+
+	var c, r, e interface{}
+	for input, output := range map[string]*interface{}{configured: &c, response: &r, expected: &e} {
+		if err := json.Unmarshal([]byte(input), output); err != nil {
+			t.Fatalf("Error parsing %q: %+v", input, err)
+		}
+	}
+
+	if actual := projectGenericResourceBody(c, r); !reflect.DeepEqual(actual, e) {
+		t.Fatalf("Expected %+v but got %+v", e, actual)
+	}
+}
+
+func TestGenericResourceValue(t *testing.T) {
+	resource := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"provisioningState": "Succeeded",
+		},
+	}
+
+	if value, ok := genericResourceValue(resource, "properties.provisioningState"); !ok || value != "Succeeded" {
+		t.Fatalf("Expected `properties.provisioningState` to be %q but got %+v", "Succeeded", value)
+	}
+
+	for _, path := range []string{"properties.missing", "properties.provisioningState.nested", "missing"} {
+		if _, ok := genericResourceValue(resource, path); ok {
+			t.Fatalf("Expected %q not to be found", path)
+		}
+	}
+}
+
+func TestLatestGenericResourceAPIVersion(t *testing.T) {
+	provider := resources.Provider{
+		ResourceTypes: &[]resources.ProviderResourceType{
+			{
+				ResourceType: utils.String("virtualNetworks"),
+				APIVersions:  &[]string{"2018-06-01-preview", "2017-09-01", "2018-04-01", "2016-03-30"},
+			},
+			{
+				ResourceType: utils.String("networkWatchers"),
+				APIVersions:  &[]string{"2018-08-01-preview"},
+			},
+		},
+	}
+
+	cases := map[string]string{
+		"virtualNetworks": "2018-04-01",
+		"VirtualNetworks": "2018-04-01",
+		"networkWatchers": "2018-08-01-preview",
+		"publicIPAddress": "",
+	}
+
+	for resourceType, expected := range cases {
+		if actual := latestGenericResourceAPIVersion(provider, resourceType); actual != expected {
+			t.Fatalf("Expected the API version for %q to be %q but got %q", resourceType, expected, actual)
+		}
+	}
+}
+
+func TestValidateGenericResourceType(t *testing.T) {
+	cases := map[string]bool{
+		"Microsoft.Network/virtualNetworks":         true,
+		"Microsoft.Network/virtualNetworks/subnets": true,
+		"Microsoft.Network":                         false,
+		"Microsoft.Network/":                        false,
+		"/virtualNetworks":                          false,
+		"":                                          false,
+	}
+
+	for value, valid := range cases {
+		_, errors := validateGenericResourceType(value, "type")
+		if valid && len(errors) > 0 {
+			t.Fatalf("Expected %q to be valid but got: %+v", value, errors)
+		}
+		if !valid && len(errors) == 0 {
+			t.Fatalf("Expected %q to be invalid", value)
+		}
+	}
+}
+
+func TestValidateGenericResourceAPIVersion(t *testing.T) {
+	cases := map[string]bool{
+		"2018-01-01":         true,
+		"2018-01-01-preview": true,
+		"2018-01-01-beta1":   true,
+		"2018-01":            false,
+		"latest":             false,
+		"":                   false,
+	}
+
+	for value, valid := range cases {
+		_, errors := validateGenericResourceAPIVersion(value, "api_version")
+		if valid && len(errors) > 0 {
+			t.Fatalf("Expected %q to be valid but got: %+v", value, errors)
+		}
+		if !valid && len(errors) == 0 {
+			t.Fatalf("Expected %q to be invalid", value)
+		}
+	}
+}
+
+func TestAccAzureRMResource_basic(t *testing.T) {
+	resourceName := "azurerm_resource.test"
+	ri := testAccRandInt(t)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResource_basic(ri, location, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.properties.provisioningState", "Succeeded"),
+				),
+			},
+			{
+				Config: testAccAzureRMResource_basic(ri, location, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMResourceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).resources().resourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, _, err := readGenericResource(ctx, client, rs.Primary.ID, rs.Primary.Attributes["api_version"])
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: Resource %q does not exist", rs.Primary.ID)
+			}
+			return fmt.Errorf("Bad: Get on resourcesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMResourceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resources().resourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_resource" {
+			continue
+		}
+
+		resp, _, err := readGenericResource(ctx, client, rs.Primary.ID, rs.Primary.Attributes["api_version"])
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Resource %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMResource_basic(rInt int, location string, addressPrefix string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource" "test" {
+  name        = "acctestvirtnet%d"
+  parent_id   = "${azurerm_resource_group.test.id}"
+  type        = "Microsoft.Network/virtualNetworks"
+  api_version = "2018-04-01"
+
+  body = <<BODY
+{
+  "location": "${azurerm_resource_group.test.location}",
+  "properties": {
+    "addressSpace": {
+      "addressPrefixes": ["%s"]
+    }
+  }
+}
+BODY
+
+  response_export_values = ["properties.provisioningState"]
+}
+`, rInt, location, rInt, addressPrefix)
+}
@@ -3,20 +3,32 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/authorization/mgmt/2015-07-01/authorization"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var roleAssignmentIDFormat = scopedResourceIDFormat{
+	description: "Role Assignment",
+	provider:    "Microsoft.Authorization",
+	segment:     resourceIDSegment{key: "roleAssignments", placeholder: "roleAssignmentName"},
+}
+
 func resourceArmRoleAssignment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmRoleAssignmentCreate,
-		Read:   resourceArmRoleAssignmentRead,
-		Delete: resourceArmRoleAssignmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmRoleAssignmentCreate,
+		Read:     resourceArmRoleAssignmentRead,
+		Delete:   resourceArmRoleAssignmentDelete,
+		Importer: scopedResourceIDImporter(roleAssignmentIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -59,9 +71,10 @@ func resourceArmRoleAssignment() *schema.Resource {
 }
 
 func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
-	roleAssignmentsClient := meta.(*ArmClient).roleAssignmentsClient
-	roleDefinitionsClient := meta.(*ArmClient).roleDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	roleAssignmentsClient := meta.(*ArmClient).authorization().roleAssignmentsClient
+	roleDefinitionsClient := meta.(*ArmClient).authorization().roleDefinitionsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	scope := d.Get("scope").(string)
@@ -71,7 +84,9 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 		roleDefinitionId = v.(string)
 	} else if v, ok := d.GetOk("role_definition_name"); ok {
 		filter := fmt.Sprintf("roleName eq '%s'", v.(string))
-		roleDefinitions, err := roleDefinitionsClient.List(ctx, "", filter)
+		// Role Definitions are looked up within the Scope, since the Role Definition ID is specific to the
+		// Subscription being assigned to - which may differ from the one the Provider is configured for
+		roleDefinitions, err := roleDefinitionsClient.List(ctx, scope, filter)
 		if err != nil {
 			return fmt.Errorf("Error loading Role Definition List: %+v", err)
 		}
@@ -86,6 +101,17 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 
 	principalId := d.Get("principal_id").(string)
 
+	if name != "" && requiresImport(d, meta) {
+		existing, err := roleAssignmentsClient.Get(ctx, scope, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Role Assignment %q (Scope %q): %+v", name, scope, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_role_assignment", *existing.ID)
+		}
+	}
+
 	if name == "" {
 		uuid, err := uuid.GenerateUUID()
 		if err != nil {
@@ -120,8 +146,9 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).roleAssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).authorization().roleAssignmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.GetByID(ctx, d.Id())
 	if err != nil {
@@ -146,8 +173,9 @@ func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmRoleAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).roleAssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).authorization().roleAssignmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	scope := d.Get("scope").(string)
 	name := d.Get("name").(string)
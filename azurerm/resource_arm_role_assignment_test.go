@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -74,7 +73,7 @@ func TestAccAzureRMRoleAssignment_builtin(t *testing.T) {
 func TestAccAzureRMRoleAssignment_custom(t *testing.T) {
 	roleDefinitionId := uuid.New().String()
 	roleAssignmentId := uuid.New().String()
-	rInt := acctest.RandInt()
+	rInt := testAccRandInt(t)
 	config := testAccAzureRMRoleAssignment_custom(roleDefinitionId, roleAssignmentId, rInt)
 
 	resource.Test(t, resource.TestCase{
@@ -102,7 +101,7 @@ func testCheckAzureRMRoleAssignmentExists(name string) resource.TestCheckFunc {
 		scope := rs.Primary.Attributes["scope"]
 		roleAssignmentName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).roleAssignmentsClient
+		client := testAccProvider.Meta().(*ArmClient).authorization().roleAssignmentsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, scope, roleAssignmentName)
 
@@ -126,7 +125,7 @@ func testCheckAzureRMRoleAssignmentDestroy(s *terraform.State) error {
 		scope := rs.Primary.Attributes["scope"]
 		roleAssignmentName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).roleAssignmentsClient
+		client := testAccProvider.Meta().(*ArmClient).authorization().roleAssignmentsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, scope, roleAssignmentName)
 
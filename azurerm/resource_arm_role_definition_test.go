@@ -5,14 +5,13 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMRoleDefinition_basic(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRoleDefinition_basic(uuid.New().String(), ri)
 
 	resource.Test(t, resource.TestCase{
@@ -31,7 +30,7 @@ func TestAccAzureRMRoleDefinition_basic(t *testing.T) {
 }
 
 func TestAccAzureRMRoleDefinition_complete(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMRoleDefinition_complete(uuid.New().String(), ri)
 
 	resource.Test(t, resource.TestCase{
@@ -52,7 +51,7 @@ func TestAccAzureRMRoleDefinition_complete(t *testing.T) {
 func TestAccAzureRMRoleDefinition_update(t *testing.T) {
 	resourceName := "azurerm_role_definition.test"
 	id := uuid.New().String()
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 
 	config := testAccAzureRMRoleDefinition_basic(id, ri)
 	updatedConfig := testAccAzureRMRoleDefinition_updated(id, ri)
@@ -97,7 +96,7 @@ func testCheckAzureRMRoleDefinitionExists(name string) resource.TestCheckFunc {
 		scope := rs.Primary.Attributes["scope"]
 		roleDefinitionId := rs.Primary.Attributes["role_definition_id"]
 
-		client := testAccProvider.Meta().(*ArmClient).roleDefinitionsClient
+		client := testAccProvider.Meta().(*ArmClient).authorization().roleDefinitionsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, scope, roleDefinitionId)
 
@@ -121,7 +120,7 @@ func testCheckAzureRMRoleDefinitionDestroy(s *terraform.State) error {
 		scope := rs.Primary.Attributes["scope"]
 		roleDefinitionId := rs.Primary.Attributes["role_definition_id"]
 
-		client := testAccProvider.Meta().(*ArmClient).roleDefinitionsClient
+		client := testAccProvider.Meta().(*ArmClient).authorization().roleDefinitionsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, scope, roleDefinitionId)
 
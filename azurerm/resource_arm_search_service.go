@@ -3,20 +3,34 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/search/mgmt/2015-08-19/search"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var searchServiceIDFormat = resourceIDFormat{
+	description: "Search Service",
+	provider:    "Microsoft.Search",
+	segments: []resourceIDSegment{
+		{key: "searchServices", placeholder: "searchServiceName"},
+	},
+}
+
 func resourceArmSearchService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSearchServiceCreateUpdate,
-		Read:   resourceArmSearchServiceRead,
-		Delete: resourceArmSearchServiceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmSearchServiceCreateUpdate,
+		Read:     resourceArmSearchServiceRead,
+		Delete:   resourceArmSearchServiceDelete,
+		Importer: resourceIDImporter(searchServiceIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,12 +78,25 @@ func resourceArmSearchService() *schema.Resource {
 }
 
 func resourceArmSearchServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).searchServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).search().searchServicesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resourceGroupName := d.Get("resource_group_name").(string)
+
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, resourceGroupName, name, nil)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Search Service %q (Resource Group %q): %+v", name, resourceGroupName, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_search_service", *existing.ID)
+		}
+	}
+
 	skuName := d.Get("sku").(string)
 	tags := d.Get("tags").(map[string]interface{})
 
@@ -79,7 +106,7 @@ func resourceArmSearchServiceCreateUpdate(d *schema.ResourceData, meta interface
 			Name: search.SkuName(skuName),
 		},
 		ServiceProperties: &search.ServiceProperties{},
-		Tags:              expandTags(tags),
+		Tags:              expandTags(tags, meta),
 	}
 
 	if v, ok := d.GetOk("replica_count"); ok {
@@ -108,8 +135,9 @@ func resourceArmSearchServiceCreateUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceArmSearchServiceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).searchServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).search().searchServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -149,14 +177,15 @@ func resourceArmSearchServiceRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmSearchServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).searchServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).search().searchServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
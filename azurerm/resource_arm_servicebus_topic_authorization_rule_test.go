@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMServiceBusTopicAuthorizationRule_listen(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMServiceBusTopicAuthorizationRule_listen(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -30,7 +29,7 @@ func TestAccAzureRMServiceBusTopicAuthorizationRule_listen(t *testing.T) {
 }
 
 func TestAccAzureRMServiceBusTopicAuthorizationRule_send(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMServiceBusTopicAuthorizationRule_send(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -49,7 +48,7 @@ func TestAccAzureRMServiceBusTopicAuthorizationRule_send(t *testing.T) {
 }
 
 func TestAccAzureRMServiceBusTopicAuthorizationRule_readwrite(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMServiceBusTopicAuthorizationRule_readWrite(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -68,7 +67,7 @@ func TestAccAzureRMServiceBusTopicAuthorizationRule_readwrite(t *testing.T) {
 }
 
 func TestAccAzureRMServiceBusTopicAuthorizationRule_manage(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMServiceBusTopicAuthorizationRule_manage(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -87,7 +86,7 @@ func TestAccAzureRMServiceBusTopicAuthorizationRule_manage(t *testing.T) {
 }
 
 func testCheckAzureRMServiceBusTopicAuthorizationRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).serviceBusTopicsClient
+	conn := testAccProvider.Meta().(*ArmClient).serviceBus().serviceBusTopicsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -127,7 +126,7 @@ func testCheckAzureRMServiceBusTopicAuthorizationRuleExists(name string) resourc
 			return fmt.Errorf("Bad: no resource group found in state for ServiceBus Topic: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*ArmClient).serviceBusTopicsClient
+		conn := testAccProvider.Meta().(*ArmClient).serviceBus().serviceBusTopicsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := conn.GetAuthorizationRule(ctx, resourceGroup, namespaceName, topicName, name)
 		if err != nil {
@@ -57,7 +57,7 @@ func TestSnapshotName_validation(t *testing.T) {
 
 func TestAccAzureRMSnapshot_fromManagedDisk(t *testing.T) {
 	resourceName := "azurerm_snapshot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMSnapshot_fromManagedDisk(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -77,8 +77,8 @@ func TestAccAzureRMSnapshot_fromManagedDisk(t *testing.T) {
 
 func TestAccAzureRMSnapshot_encryption(t *testing.T) {
 	resourceName := "azurerm_snapshot.test"
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	config := testAccAzureRMSnapshot_encryption(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -98,7 +98,7 @@ func TestAccAzureRMSnapshot_encryption(t *testing.T) {
 
 func TestAccAzureRMSnapshot_update(t *testing.T) {
 	resourceName := "azurerm_snapshot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMSnapshot_fromManagedDisk(ri, testLocation())
 	updatedConfig := testAccAzureRMSnapshot_fromManagedDiskUpdated(ri, testLocation())
 
@@ -125,7 +125,7 @@ func TestAccAzureRMSnapshot_update(t *testing.T) {
 
 func TestAccAzureRMSnapshot_extendingManagedDisk(t *testing.T) {
 	resourceName := "azurerm_snapshot.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMSnapshot_extendingManagedDisk(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -145,7 +145,7 @@ func TestAccAzureRMSnapshot_extendingManagedDisk(t *testing.T) {
 
 func TestAccAzureRMSnapshot_fromExistingSnapshot(t *testing.T) {
 	resourceName := "azurerm_snapshot.second"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMSnapshot_fromExistingSnapshot(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -165,8 +165,8 @@ func TestAccAzureRMSnapshot_fromExistingSnapshot(t *testing.T) {
 
 func TestAccAzureRMSnapshot_fromUnmanagedDisk(t *testing.T) {
 	resourceName := "azurerm_snapshot.test"
-	ri := acctest.RandInt()
-	rs := acctest.RandString(4)
+	ri := testAccRandInt(t)
+	rs := testAccRandString(t, 4)
 	config := testAccAzureRMSnapshot_fromUnmanagedDisk(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -193,7 +193,7 @@ func testCheckAzureRMSnapshotDestroy(s *terraform.State) error {
 		name := rs.Primary.Attributes["name"]
 		resourceGroup := rs.Primary.Attributes["resource_group_name"]
 
-		client := testAccProvider.Meta().(*ArmClient).snapshotsClient
+		client := testAccProvider.Meta().(*ArmClient).compute().snapshotsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
@@ -223,7 +223,7 @@ func testCheckAzureRMSnapshotExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for Snapshot: %q", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).snapshotsClient
+		client := testAccProvider.Meta().(*ArmClient).compute().snapshotsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -12,7 +11,7 @@ import (
 
 func TestAccAzureRMSqlFirewallRule_basic(t *testing.T) {
 	resourceName := "azurerm_sql_firewall_rule.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	preConfig := testAccAzureRMSqlFirewallRule_basic(ri, testLocation())
 	postConfig := testAccAzureRMSqlFirewallRule_withUpdates(ri, testLocation())
 
@@ -43,7 +42,7 @@ func TestAccAzureRMSqlFirewallRule_basic(t *testing.T) {
 
 func TestAccAzureRMSqlFirewallRule_disappears(t *testing.T) {
 	resourceName := "azurerm_sql_firewall_rule.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMSqlFirewallRule_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -74,7 +73,7 @@ func testCheckAzureRMSqlFirewallRuleExists(name string) resource.TestCheckFunc {
 		serverName := rs.Primary.Attributes["server_name"]
 		ruleName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).sqlFirewallRulesClient
+		client := testAccProvider.Meta().(*ArmClient).databases().sqlFirewallRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, ruleName)
@@ -100,7 +99,7 @@ func testCheckAzureRMSqlFirewallRuleDestroy(s *terraform.State) error {
 		serverName := rs.Primary.Attributes["server_name"]
 		ruleName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).sqlFirewallRulesClient
+		client := testAccProvider.Meta().(*ArmClient).databases().sqlFirewallRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, serverName, ruleName)
@@ -130,7 +129,7 @@ func testCheckAzureRMSqlFirewallRuleDisappears(name string) resource.TestCheckFu
 		serverName := rs.Primary.Attributes["server_name"]
 		ruleName := rs.Primary.Attributes["name"]
 
-		client := testAccProvider.Meta().(*ArmClient).sqlFirewallRulesClient
+		client := testAccProvider.Meta().(*ArmClient).databases().sqlFirewallRulesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Delete(ctx, resourceGroup, serverName, ruleName)
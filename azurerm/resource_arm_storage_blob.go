@@ -11,17 +11,38 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+var storageBlobIDFormat = resourceIDFormat{
+	description: "Storage Blob",
+	provider:    "Microsoft.Storage",
+	segments: []resourceIDSegment{
+		{key: "storageAccounts", placeholder: "storageAccountName"},
+		{key: "blobServices", value: "default"},
+		{key: "containers", placeholder: "containerName"},
+		{key: "blobs", placeholder: "blobName"},
+	},
+}
+
 func resourceArmStorageBlob() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageBlobCreate,
 		Read:   resourceArmStorageBlobRead,
 		Exists: resourceArmStorageBlobExists,
 		Delete: resourceArmStorageBlobDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmStorageBlobImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -149,6 +170,18 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 	cont := d.Get("storage_container_name").(string)
 	sourceUri := d.Get("source_uri").(string)
 
+	if requiresImport(d, meta) {
+		exists, err := blobClient.GetContainerReference(cont).GetBlobReference(name).Exists()
+		if err != nil {
+			return fmt.Errorf("Error checking for presence of existing Storage Blob %q (Container %q / Storage Account %q / Resource Group %q): %+v", name, cont, storageAccountName, resourceGroupName, err)
+		}
+
+		if exists {
+			id := storageBlobIDFormat.format(armClient.subscriptionId, resourceGroupName, storageAccountName, cont, name)
+			return importAsExistsError("azurerm_storage_blob", id)
+		}
+	}
+
 	log.Printf("[INFO] Creating blob %q in storage account %q", name, storageAccountName)
 	if sourceUri != "" {
 		options := &storage.CopyOptions{}
@@ -523,6 +556,60 @@ func resourceArmStorageBlobBlockUploadWorker(ctx resourceArmStorageBlobBlockUplo
 	}
 }
 
+// resourceArmStorageBlobImport imports a blob using an ID in the format of storageBlobIDFormat, setting the
+// fields which the Read doesn't. Since the name of a blob can contain slashes, everything after `/blobs/` is the name.
+func resourceArmStorageBlobImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	index := strings.Index(strings.ToLower(d.Id()), "/blobs/")
+	if index == -1 || strings.Trim(d.Id()[index+len("/blobs/"):], "/") == "" {
+		return nil, fmt.Errorf("Error importing %q: Expected a Storage Blob ID in the format %q", d.Id(), storageBlobIDFormat.String())
+	}
+
+	id, err := storageContainerIDFormat.parse(d.Id()[:index])
+	if err != nil {
+		return nil, fmt.Errorf("Error importing %q: Expected a Storage Blob ID in the format %q: %+v", d.Id(), storageBlobIDFormat.String(), err)
+	}
+
+	resourceGroupName := id.resourceGroup
+	storageAccountName := id.names[0]
+	storageContainerName := id.names[1]
+	name := strings.Trim(d.Id()[index+len("/blobs/"):], "/")
+
+	armClient := meta.(*ArmClient)
+	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return nil, err
+	}
+	if !accountExists {
+		return nil, fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	blob := blobClient.GetContainerReference(storageContainerName).GetBlobReference(name)
+	if err := blob.GetProperties(nil); err != nil {
+		return nil, fmt.Errorf("Error retrieving storage blob %q: %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroupName)
+	d.Set("storage_account_name", storageAccountName)
+	d.Set("storage_container_name", storageContainerName)
+	d.Set("parallelism", 8)
+	d.Set("attempts", 1)
+
+	switch blob.Properties.BlobType {
+	case storage.BlobTypeBlock:
+		d.Set("type", "block")
+		d.Set("size", 0)
+	case storage.BlobTypePage:
+		d.Set("type", "page")
+		d.Set("size", int(blob.Properties.ContentLength))
+	default:
+		return nil, fmt.Errorf("Storage blob %q is a %q blob, only block and page blobs can be imported", name, string(blob.Properties.BlobType))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
@@ -10,7 +10,6 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -144,8 +143,8 @@ func TestResourceAzureRMStorageBlobAttempts_validation(t *testing.T) {
 }
 
 func TestAccAzureRMStorageBlob_basic(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	ri := testAccRandInt(t)
+	rs := strings.ToLower(testAccRandString(t, 11))
 	config := testAccAzureRMStorageBlob_basic(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -164,8 +163,8 @@ func TestAccAzureRMStorageBlob_basic(t *testing.T) {
 }
 
 func TestAccAzureRMStorageBlob_disappears(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	ri := testAccRandInt(t)
+	rs := strings.ToLower(testAccRandString(t, 11))
 	config := testAccAzureRMStorageBlob_basic(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -186,8 +185,8 @@ func TestAccAzureRMStorageBlob_disappears(t *testing.T) {
 }
 
 func TestAccAzureRMStorageBlobBlock_source(t *testing.T) {
-	ri := acctest.RandInt()
-	rs1 := strings.ToLower(acctest.RandString(11))
+	ri := testAccRandInt(t)
+	rs1 := strings.ToLower(testAccRandString(t, 11))
 	sourceBlob, err := ioutil.TempFile("", "")
 	if err != nil {
 		t.Fatalf("Failed to create local source blob file")
@@ -221,8 +220,8 @@ func TestAccAzureRMStorageBlobBlock_source(t *testing.T) {
 }
 
 func TestAccAzureRMStorageBlobPage_source(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	ri := testAccRandInt(t)
+	rs := strings.ToLower(testAccRandString(t, 11))
 	sourceBlob, err := ioutil.TempFile("", "")
 	if err != nil {
 		t.Fatalf("Failed to create local source blob file")
@@ -280,8 +279,8 @@ func TestAccAzureRMStorageBlobPage_source(t *testing.T) {
 }
 
 func TestAccAzureRMStorageBlob_source_uri(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	ri := testAccRandInt(t)
+	rs := strings.ToLower(testAccRandString(t, 11))
 	sourceBlob, err := ioutil.TempFile("", "")
 	if err != nil {
 		t.Fatalf("Failed to create local source blob file")
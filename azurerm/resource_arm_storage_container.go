@@ -3,22 +3,38 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
-	"regexp"
-
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+var storageContainerIDFormat = resourceIDFormat{
+	description: "Storage Container",
+	provider:    "Microsoft.Storage",
+	segments: []resourceIDSegment{
+		{key: "storageAccounts", placeholder: "storageAccountName"},
+		{key: "blobServices", value: "default"},
+		{key: "containers", placeholder: "containerName"},
+	},
+}
+
 func resourceArmStorageContainer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmStorageContainerCreate,
-		Read:   resourceArmStorageContainerRead,
-		Exists: resourceArmStorageContainerExists,
-		Delete: resourceArmStorageContainerDelete,
+		Create:   resourceArmStorageContainerCreate,
+		Read:     resourceArmStorageContainerRead,
+		Exists:   resourceArmStorageContainerExists,
+		Delete:   resourceArmStorageContainerDelete,
+		Importer: storageResourceImporter(storageContainerIDFormat, resourceArmStorageContainerImport),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -97,6 +113,18 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 
 	name := d.Get("name").(string)
 
+	if requiresImport(d, meta) {
+		exists, err := blobClient.GetContainerReference(name).Exists()
+		if err != nil {
+			return fmt.Errorf("Error checking for presence of existing Storage Container %q (Storage Account %q / Resource Group %q): %+v", name, storageAccountName, resourceGroupName, err)
+		}
+
+		if exists {
+			id := storageContainerIDFormat.format(armClient.subscriptionId, resourceGroupName, storageAccountName, name)
+			return importAsExistsError("azurerm_storage_container", id)
+		}
+	}
+
 	var accessType storage.ContainerAccessType
 	if d.Get("container_access_type").(string) == "private" {
 		accessType = storage.ContainerAccessType("")
@@ -137,6 +165,37 @@ func checkContainerIsCreated(reference *storage.Container) func() *resource.Retr
 	}
 }
 
+// resourceArmStorageContainerImport sets the access type of an imported storage container, which the Read doesn't
+func resourceArmStorageContainerImport(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	blobClient, accountExists, err := armClient.getBlobStorageClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	name := d.Get("name").(string)
+	reference := blobClient.GetContainerReference(name)
+	permissions, err := reference.GetPermissions(nil)
+	if err != nil {
+		return fmt.Errorf("Error retrieving permissions for storage container %q in storage account %q: %s", name, storageAccountName, err)
+	}
+
+	accessType := string(permissions.AccessType)
+	if permissions.AccessType == storage.ContainerAccessTypePrivate {
+		accessType = "private"
+	}
+	d.Set("container_access_type", accessType)
+
+	return nil
+}
+
 // resourceAzureStorageContainerRead does all the necessary API calls to
 // read the status of the storage container off Azure.
 func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
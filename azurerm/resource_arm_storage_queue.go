@@ -4,17 +4,35 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+var storageQueueIDFormat = resourceIDFormat{
+	description: "Storage Queue",
+	provider:    "Microsoft.Storage",
+	segments: []resourceIDSegment{
+		{key: "storageAccounts", placeholder: "storageAccountName"},
+		{key: "queueServices", value: "default"},
+		{key: "queues", placeholder: "queueName"},
+	},
+}
+
 func resourceArmStorageQueue() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmStorageQueueCreate,
-		Read:   resourceArmStorageQueueRead,
-		Exists: resourceArmStorageQueueExists,
-		Delete: resourceArmStorageQueueDelete,
+		Create:   resourceArmStorageQueueCreate,
+		Read:     resourceArmStorageQueueRead,
+		Exists:   resourceArmStorageQueueExists,
+		Delete:   resourceArmStorageQueueDelete,
+		Importer: storageResourceImporter(storageQueueIDFormat, nil),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -78,6 +96,18 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 
 	name := d.Get("name").(string)
 
+	if requiresImport(d, meta) {
+		exists, err := queueClient.GetQueueReference(name).Exists()
+		if err != nil {
+			return fmt.Errorf("Error checking for presence of existing Storage Queue %q (Storage Account %q / Resource Group %q): %+v", name, storageAccountName, resourceGroupName, err)
+		}
+
+		if exists {
+			id := storageQueueIDFormat.format(armClient.subscriptionId, resourceGroupName, storageAccountName, name)
+			return importAsExistsError("azurerm_storage_queue", id)
+		}
+	}
+
 	log.Printf("[INFO] Creating queue %q in storage account %q", name, storageAccountName)
 	queueReference := queueClient.GetQueueReference(name)
 	options := &storage.QueueServiceOptions{}
@@ -51,8 +51,8 @@ func TestResourceAzureRMStorageQueueName_Validation(t *testing.T) {
 }
 
 func TestAccAzureRMStorageQueue_basic(t *testing.T) {
-	ri := acctest.RandInt()
-	rs := strings.ToLower(acctest.RandString(11))
+	ri := testAccRandInt(t)
+	rs := strings.ToLower(testAccRandString(t, 11))
 	config := testAccAzureRMStorageQueue_basic(ri, rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
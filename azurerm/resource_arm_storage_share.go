@@ -4,17 +4,35 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+var storageShareIDFormat = resourceIDFormat{
+	description: "Storage Share",
+	provider:    "Microsoft.Storage",
+	segments: []resourceIDSegment{
+		{key: "storageAccounts", placeholder: "storageAccountName"},
+		{key: "fileServices", value: "default"},
+		{key: "shares", placeholder: "shareName"},
+	},
+}
+
 func resourceArmStorageShare() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmStorageShareCreate,
-		Read:   resourceArmStorageShareRead,
-		Exists: resourceArmStorageShareExists,
-		Delete: resourceArmStorageShareDelete,
+		Create:   resourceArmStorageShareCreate,
+		Read:     resourceArmStorageShareRead,
+		Exists:   resourceArmStorageShareExists,
+		Delete:   resourceArmStorageShareDelete,
+		Importer: storageResourceImporter(storageShareIDFormat, resourceArmStorageShareImport),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -57,6 +75,19 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	name := d.Get("name").(string)
+
+	if requiresImport(d, meta) {
+		exists, err := fileClient.GetShareReference(name).Exists()
+		if err != nil {
+			return fmt.Errorf("Error checking for presence of existing Storage Share %q (Storage Account %q / Resource Group %q): %+v", name, storageAccountName, resourceGroupName, err)
+		}
+
+		if exists {
+			id := storageShareIDFormat.format(armClient.subscriptionId, resourceGroupName, storageAccountName, name)
+			return importAsExistsError("azurerm_storage_share", id)
+		}
+	}
+
 	metaData := make(map[string]string) // TODO: support MetaData
 	options := &storage.FileRequestOptions{}
 
@@ -78,6 +109,31 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 	return resourceArmStorageShareRead(d, meta)
 }
 
+// resourceArmStorageShareImport sets the quota of an imported share, which the Read doesn't
+func resourceArmStorageShareImport(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	storageAccountName := d.Get("storage_account_name").(string)
+
+	fileClient, accountExists, err := armClient.getFileServiceClientForStorageAccount(resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	name := d.Get("name").(string)
+	reference := fileClient.GetShareReference(name)
+	if err := reference.FetchAttributes(nil); err != nil {
+		return fmt.Errorf("Error retrieving share %q: %s", name, err)
+	}
+	d.Set("quota", reference.Properties.Quota)
+
+	return nil
+}
+
 func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 
@@ -3,17 +3,36 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+var storageTableIDFormat = resourceIDFormat{
+	description: "Storage Table",
+	provider:    "Microsoft.Storage",
+	segments: []resourceIDSegment{
+		{key: "storageAccounts", placeholder: "storageAccountName"},
+		{key: "tableServices", value: "default"},
+		{key: "tables", placeholder: "tableName"},
+	},
+}
+
 func resourceArmStorageTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmStorageTableCreate,
-		Read:   resourceArmStorageTableRead,
-		Delete: resourceArmStorageTableDelete,
+		Create:   resourceArmStorageTableCreate,
+		Read:     resourceArmStorageTableRead,
+		Delete:   resourceArmStorageTableDelete,
+		Importer: storageResourceImporter(storageTableIDFormat, nil),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -65,6 +84,18 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 	name := d.Get("name").(string)
 	table := tableClient.GetTableReference(name)
 
+	if requiresImport(d, meta) {
+		err := table.Get(uint(60), storage.NoMetadata)
+		if err == nil {
+			id := storageTableIDFormat.format(armClient.subscriptionId, resourceGroupName, storageAccountName, name)
+			return importAsExistsError("azurerm_storage_table", id)
+		}
+
+		if storageErr, ok := err.(storage.AzureStorageServiceError); !ok || storageErr.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Error checking for presence of existing Storage Table %q (Storage Account %q / Resource Group %q): %+v", name, storageAccountName, resourceGroupName, err)
+		}
+	}
+
 	log.Printf("[INFO] Creating table %q in storage account %q.", name, storageAccountName)
 
 	timeout := uint(60)
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
@@ -15,7 +14,7 @@ import (
 
 func TestAccAzureRMSubnet_basic(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMSubnet_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -34,7 +33,7 @@ func TestAccAzureRMSubnet_basic(t *testing.T) {
 }
 
 func TestAccAzureRMSubnet_routeTableUpdate(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	initConfig := testAccAzureRMSubnet_routeTable(ri, location)
 	updatedConfig := testAccAzureRMSubnet_updatedRouteTable(ri, location)
@@ -63,7 +62,7 @@ func TestAccAzureRMSubnet_routeTableUpdate(t *testing.T) {
 
 func TestAccAzureRMSubnet_routeTableRemove(t *testing.T) {
 	resourceName := "azurerm_subnet.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	initConfig := testAccAzureRMSubnet_routeTable(ri, location)
 	updatedConfig := testAccAzureRMSubnet_routeTableUnlinked(ri, location)
@@ -94,7 +93,7 @@ func TestAccAzureRMSubnet_routeTableRemove(t *testing.T) {
 
 func TestAccAzureRMSubnet_removeNetworkSecurityGroup(t *testing.T) {
 	resourceName := "azurerm_subnet.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	location := testLocation()
 	initConfig := testAccAzureRMSubnet_networkSecurityGroup(ri, location)
 	updatedConfig := testAccAzureRMSubnet_networkSecurityGroupDetached(ri, location)
@@ -124,7 +123,7 @@ func TestAccAzureRMSubnet_removeNetworkSecurityGroup(t *testing.T) {
 }
 
 func TestAccAzureRMSubnet_bug7986(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	initConfig := testAccAzureRMSubnet_bug7986(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -144,7 +143,7 @@ func TestAccAzureRMSubnet_bug7986(t *testing.T) {
 }
 
 func TestAccAzureRMSubnet_bug15204(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	initConfig := testAccAzureRMSubnet_bug15204(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -163,7 +162,7 @@ func TestAccAzureRMSubnet_bug15204(t *testing.T) {
 }
 
 func TestAccAzureRMSubnet_disappears(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMSubnet_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -200,7 +199,7 @@ func testCheckAzureRMSubnetExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for subnet: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).subnetClient
+		client := testAccProvider.Meta().(*ArmClient).networking().subnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, vnetName, name, "")
@@ -233,8 +232,8 @@ func testCheckAzureRMSubnetRouteTableExists(subnetName string, routeTableId stri
 			return fmt.Errorf("Bad: no resource group found in state for subnet: %s", name)
 		}
 
-		networksClient := testAccProvider.Meta().(*ArmClient).vnetClient
-		subnetsClient := testAccProvider.Meta().(*ArmClient).subnetClient
+		networksClient := testAccProvider.Meta().(*ArmClient).networking().vnetClient
+		subnetsClient := testAccProvider.Meta().(*ArmClient).networking().subnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		vnetResp, vnetErr := networksClient.Get(ctx, resourceGroup, vnetName, "")
@@ -282,7 +281,7 @@ func testCheckAzureRMSubnetDisappears(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: no resource group found in state for subnet: %s", name)
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).subnetClient
+		client := testAccProvider.Meta().(*ArmClient).networking().subnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		future, err := client.Delete(ctx, resourceGroup, vnetName, name)
 		if err != nil {
@@ -301,7 +300,7 @@ func testCheckAzureRMSubnetDisappears(name string) resource.TestCheckFunc {
 }
 
 func testCheckAzureRMSubnetDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).subnetClient
+	client := testAccProvider.Meta().(*ArmClient).networking().subnetClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -327,7 +326,7 @@ func testCheckAzureRMSubnetDestroy(s *terraform.State) error {
 
 func TestAccAzureRMSubnet_serviceEndpoints(t *testing.T) {
 
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMSubnet_serviceEndpoints(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
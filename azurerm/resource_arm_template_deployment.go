@@ -12,15 +12,32 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var templateDeploymentIDFormat = resourceIDFormat{
+	description: "Template Deployment",
+	provider:    "Microsoft.Resources",
+	segments: []resourceIDSegment{
+		{key: "deployments", placeholder: "deploymentName"},
+	},
+}
+
 func resourceArmTemplateDeployment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmTemplateDeploymentCreate,
-		Read:   resourceArmTemplateDeploymentRead,
-		Update: resourceArmTemplateDeploymentCreate,
-		Delete: resourceArmTemplateDeploymentDelete,
+		Create:   resourceArmTemplateDeploymentCreate,
+		Read:     resourceArmTemplateDeploymentRead,
+		Update:   resourceArmTemplateDeploymentCreate,
+		Delete:   resourceArmTemplateDeploymentDelete,
+		Importer: resourceIDImporter(templateDeploymentIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -58,11 +75,24 @@ func resourceArmTemplateDeployment() *schema.Resource {
 
 func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	deployClient := client.resources().deploymentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(client.StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requiresImport(d, meta) {
+		existing, err := deployClient.Get(ctx, resourceGroup, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_template_deployment", *existing.ID)
+		}
+	}
+
 	deploymentMode := d.Get("deployment_mode").(string)
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Template Deployment creation.")
@@ -103,7 +133,7 @@ func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error creating deployment: %+v", err)
 	}
 
-	err = future.WaitForCompletion(ctx, deployClient.Client)
+	err = waitForCreation(ctx, d, meta, future.Future, deployClient.Client)
 	if err != nil {
 		return fmt.Errorf("Error creating deployment: %+v", err)
 	}
@@ -123,8 +153,9 @@ func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{
 
 func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	deployClient := client.resources().deploymentsClient
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -145,6 +176,14 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error making Read request on Azure RM Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+
+	// the Deployment Mode is returned in a different casing to the one which may have been specified
+	if mode := string(resp.Properties.Mode); !strings.EqualFold(d.Get("deployment_mode").(string), mode) {
+		d.Set("deployment_mode", mode)
+	}
+
 	var outputs map[string]string
 	if resp.Properties.Outputs != nil && len(*resp.Properties.Outputs) > 0 {
 		outputs = make(map[string]string)
@@ -187,8 +226,9 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	deployClient := client.resources().deploymentsClient
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -234,11 +274,16 @@ func normalizeJson(jsonString interface{}) string {
 func waitForTemplateDeploymentToBeDeleted(ctx context.Context, client resources.DeploymentsClient, resourceGroup, name string) error {
 	// we can't use the Waiter here since the API returns a 200 once it's deleted which is considered a polling status code..
 	log.Printf("[DEBUG] Waiting for Template Deployment (%q in Resource Group %q) to be deleted", name, resourceGroup)
+	timeout, err := timeouts.Remaining(ctx)
+	if err != nil {
+		return fmt.Errorf("Error determining the timeout for waiting on the deletion of Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"200"},
 		Target:  []string{"404"},
 		Refresh: templateDeploymentStateStatusCodeRefreshFunc(ctx, client, resourceGroup, name),
-		Timeout: 40 * time.Minute,
+		Timeout: timeout,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
 		return fmt.Errorf("Error waiting for Template Deployment (%q in Resource Group %q) to be deleted: %+v", name, resourceGroup, err)
@@ -4,21 +4,56 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2017-05-01/trafficmanager"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// the ID of a Traffic Manager Endpoint contains the type of the Endpoint
+var trafficManagerEndpointIDFormats = []resourceIDFormat{
+	{
+		description: "Traffic Manager Endpoint",
+		provider:    "Microsoft.Network",
+		segments: []resourceIDSegment{
+			{key: "trafficManagerProfiles", placeholder: "profileName"},
+			{key: "azureEndpoints", placeholder: "endpointName"},
+		},
+	},
+	{
+		description: "Traffic Manager Endpoint",
+		provider:    "Microsoft.Network",
+		segments: []resourceIDSegment{
+			{key: "trafficManagerProfiles", placeholder: "profileName"},
+			{key: "externalEndpoints", placeholder: "endpointName"},
+		},
+	},
+	{
+		description: "Traffic Manager Endpoint",
+		provider:    "Microsoft.Network",
+		segments: []resourceIDSegment{
+			{key: "trafficManagerProfiles", placeholder: "profileName"},
+			{key: "nestedEndpoints", placeholder: "endpointName"},
+		},
+	},
+}
+
 func resourceArmTrafficManagerEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmTrafficManagerEndpointCreate,
-		Read:   resourceArmTrafficManagerEndpointRead,
-		Update: resourceArmTrafficManagerEndpointCreate,
-		Delete: resourceArmTrafficManagerEndpointDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmTrafficManagerEndpointCreate,
+		Read:     resourceArmTrafficManagerEndpointRead,
+		Update:   resourceArmTrafficManagerEndpointCreate,
+		Delete:   resourceArmTrafficManagerEndpointDelete,
+		Importer: resourceIDImporter(trafficManagerEndpointIDFormats...),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -53,8 +88,9 @@ func resourceArmTrafficManagerEndpoint() *schema.Resource {
 			},
 
 			"target_resource_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateResourceID,
 			},
 
 			"endpoint_status": {
@@ -102,7 +138,7 @@ func resourceArmTrafficManagerEndpoint() *schema.Resource {
 }
 
 func resourceArmTrafficManagerEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerEndpointsClient
+	client := meta.(*ArmClient).trafficManager().trafficManagerEndpointsClient
 
 	log.Printf("[INFO] preparing arguments for ARM TrafficManager Endpoint creation.")
 
@@ -118,7 +154,20 @@ func resourceArmTrafficManagerEndpointCreate(d *schema.ResourceData, meta interf
 		EndpointProperties: getArmTrafficManagerEndpointProperties(d),
 	}
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, resGroup, profileName, endpointType, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Traffic Manager Endpoint %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_traffic_manager_endpoint", *existing.ID)
+		}
+	}
+
 	_, err := client.CreateOrUpdate(ctx, resGroup, profileName, endpointType, name, params)
 	if err != nil {
 		return err
@@ -138,7 +187,7 @@ func resourceArmTrafficManagerEndpointCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceArmTrafficManagerEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerEndpointsClient
+	client := meta.(*ArmClient).trafficManager().trafficManagerEndpointsClient
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -159,7 +208,8 @@ func resourceArmTrafficManagerEndpointRead(d *schema.ResourceData, meta interfac
 	// endpoint name is keyed by endpoint type in ARM ID
 	name := id.Path[endpointType]
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Get(ctx, resGroup, profileName, endpointType, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -188,7 +238,7 @@ func resourceArmTrafficManagerEndpointRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmTrafficManagerEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerEndpointsClient
+	client := meta.(*ArmClient).trafficManager().trafficManagerEndpointsClient
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -200,7 +250,8 @@ func resourceArmTrafficManagerEndpointDelete(d *schema.ResourceData, meta interf
 
 	// endpoint name is keyed by endpoint type in ARM ID
 	name := id.Path[endpointType]
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Delete(ctx, resGroup, profileName, endpointType, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -5,22 +5,37 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2017-05-01/trafficmanager"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var trafficManagerProfileIDFormat = resourceIDFormat{
+	description: "Traffic Manager Profile",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "trafficManagerProfiles", placeholder: "profileName"},
+	},
+}
+
 func resourceArmTrafficManagerProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmTrafficManagerProfileCreate,
-		Read:   resourceArmTrafficManagerProfileRead,
-		Update: resourceArmTrafficManagerProfileCreate,
-		Delete: resourceArmTrafficManagerProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmTrafficManagerProfileCreate,
+		Read:     resourceArmTrafficManagerProfileRead,
+		Update:   resourceArmTrafficManagerProfileCreate,
+		Delete:   resourceArmTrafficManagerProfileDelete,
+		Importer: resourceIDImporter(trafficManagerProfileIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -114,7 +129,7 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 }
 
 func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerProfilesClient
+	client := meta.(*ArmClient).trafficManager().trafficManagerProfilesClient
 
 	log.Printf("[INFO] preparing arguments for Azure ARM virtual network creation.")
 
@@ -128,10 +143,23 @@ func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interfa
 		Name:              &name,
 		Location:          &location,
 		ProfileProperties: getArmTrafficManagerProfileProperties(d),
-		Tags:              expandTags(tags),
+		Tags:              expandTags(tags, meta),
+	}
+
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, resGroup, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Traffic Manager Profile %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_traffic_manager_profile", *existing.ID)
+		}
 	}
 
-	ctx := meta.(*ArmClient).StopContext
 	_, err := client.CreateOrUpdate(ctx, resGroup, name, profile)
 	if err != nil {
 		return err
@@ -151,8 +179,9 @@ func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).trafficManager().trafficManagerProfilesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -187,13 +216,13 @@ func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface
 	monitorFlat := flattenAzureRMTrafficManagerProfileMonitorConfig(profile.MonitorConfig)
 	d.Set("monitor_config", schema.NewSet(resourceAzureRMTrafficManagerMonitorConfigHash, monitorFlat))
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmTrafficManagerProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).trafficManagerProfilesClient
+	client := meta.(*ArmClient).trafficManager().trafficManagerProfilesClient
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -202,7 +231,8 @@ func resourceArmTrafficManagerProfileDelete(d *schema.ResourceData, meta interfa
 	resGroup := id.ResourceGroup
 	name := id.Path["trafficManagerProfiles"]
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp.Response) {
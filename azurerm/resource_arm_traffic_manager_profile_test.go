@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -22,7 +21,7 @@ func getTrafficManagerFQDN(hostname string) (string, error) {
 
 func TestAccAzureRMTrafficManagerProfile_weighted(t *testing.T) {
 	resourceName := "azurerm_traffic_manager_profile.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMTrafficManagerProfile_weighted(ri, testLocation())
 
 	fqdn, err := getTrafficManagerFQDN(fmt.Sprintf("acctesttmp%d", ri))
@@ -49,7 +48,7 @@ func TestAccAzureRMTrafficManagerProfile_weighted(t *testing.T) {
 
 func TestAccAzureRMTrafficManagerProfile_weightedTCP(t *testing.T) {
 	resourceName := "azurerm_traffic_manager_profile.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMTrafficManagerProfile_weightedTCP(ri, testLocation())
 
 	fqdn, err := getTrafficManagerFQDN(fmt.Sprintf("acctesttmp%d", ri))
@@ -76,7 +75,7 @@ func TestAccAzureRMTrafficManagerProfile_weightedTCP(t *testing.T) {
 
 func TestAccAzureRMTrafficManagerProfile_performance(t *testing.T) {
 	resourceName := "azurerm_traffic_manager_profile.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMTrafficManagerProfile_performance(ri, testLocation())
 
 	fqdn, err := getTrafficManagerFQDN(fmt.Sprintf("acctesttmp%d", ri))
@@ -103,7 +102,7 @@ func TestAccAzureRMTrafficManagerProfile_performance(t *testing.T) {
 
 func TestAccAzureRMTrafficManagerProfile_priority(t *testing.T) {
 	resourceName := "azurerm_traffic_manager_profile.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMTrafficManagerProfile_priority(ri, testLocation())
 
 	fqdn, err := getTrafficManagerFQDN(fmt.Sprintf("acctesttmp%d", ri))
@@ -130,7 +129,7 @@ func TestAccAzureRMTrafficManagerProfile_priority(t *testing.T) {
 
 func TestAccAzureRMTrafficManagerProfile_withTags(t *testing.T) {
 	resourceName := "azurerm_traffic_manager_profile.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	preConfig := testAccAzureRMTrafficManagerProfile_withTags(ri, testLocation())
 	postConfig := testAccAzureRMTrafficManagerProfile_withTagsUpdated(ri, testLocation())
 
@@ -175,7 +174,7 @@ func testCheckAzureRMTrafficManagerProfileExists(name string) resource.TestCheck
 		}
 
 		// Ensure resource group/virtual network combination exists in API
-		conn := testAccProvider.Meta().(*ArmClient).trafficManagerProfilesClient
+		conn := testAccProvider.Meta().(*ArmClient).trafficManager().trafficManagerProfilesClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 		resp, err := conn.Get(ctx, resourceGroup, name)
 		if err != nil {
@@ -191,7 +190,7 @@ func testCheckAzureRMTrafficManagerProfileExists(name string) resource.TestCheck
 }
 
 func testCheckAzureRMTrafficManagerProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*ArmClient).trafficManagerProfilesClient
+	conn := testAccProvider.Meta().(*ArmClient).trafficManager().trafficManagerProfilesClient
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_traffic_manager_profile" {
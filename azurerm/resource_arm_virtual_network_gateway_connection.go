@@ -3,22 +3,37 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var virtualNetworkGatewayConnectionIDFormat = resourceIDFormat{
+	description: "Virtual Network Gateway Connection",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "connections", placeholder: "connectionName"},
+	},
+}
+
 func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmVirtualNetworkGatewayConnectionCreateUpdate,
-		Read:   resourceArmVirtualNetworkGatewayConnectionRead,
-		Update: resourceArmVirtualNetworkGatewayConnectionCreateUpdate,
-		Delete: resourceArmVirtualNetworkGatewayConnectionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmVirtualNetworkGatewayConnectionCreateUpdate,
+		Read:     resourceArmVirtualNetworkGatewayConnectionRead,
+		Update:   resourceArmVirtualNetworkGatewayConnectionCreateUpdate,
+		Delete:   resourceArmVirtualNetworkGatewayConnectionDelete,
+		Importer: resourceIDImporter(virtualNetworkGatewayConnectionIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -49,9 +64,10 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 			},
 
 			"virtual_network_gateway_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateVirtualNetworkGatewayID,
 			},
 
 			"authorization_key": {
@@ -60,20 +76,23 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 			},
 
 			"express_route_circuit_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateExpressRouteCircuitID,
 			},
 
 			"peer_virtual_network_gateway_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateVirtualNetworkGatewayID,
 			},
 
 			"local_network_gateway_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateLocalNetworkGatewayID,
 			},
 
 			"enable_bgp": {
@@ -100,14 +119,30 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 }
 
 func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetGatewayConnectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	// the Connection is created in the same Subscription as the Virtual Network Gateway
+	armClient := meta.(*ArmClient)
+	subscriptionId := armClient.subscriptionIdFromResourceId(d.Get("virtual_network_gateway_id").(string))
+	client := armClient.vnetGatewayConnectionsClientForSubscription(subscriptionId)
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Virtual Network Gateway Connection creation.")
 
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
+
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, resGroup, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_virtual_network_gateway_connection", *existing.ID)
+		}
+	}
+
 	tags := d.Get("tags").(map[string]interface{})
 
 	properties, err := getArmVirtualNetworkGatewayConnectionProperties(d)
@@ -118,7 +153,7 @@ func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceDa
 	connection := network.VirtualNetworkGatewayConnection{
 		Name:     &name,
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		VirtualNetworkGatewayConnectionPropertiesFormat: properties,
 	}
 
@@ -127,7 +162,7 @@ func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceDa
 		return fmt.Errorf("Error Creating/Updating AzureRM Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletion(ctx, client.Client)
+	err = waitForCreation(ctx, d, meta, future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for completion of Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -146,8 +181,10 @@ func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceDa
 }
 
 func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetGatewayConnectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	armClient := meta.(*ArmClient)
+	client := armClient.vnetGatewayConnectionsClientForSubscription(armClient.subscriptionIdFromResourceId(d.Id()))
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayConnectionFromId(d.Id())
 	if err != nil {
@@ -198,14 +235,16 @@ func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta
 	d.Set("routing_weight", conn.RoutingWeight)
 	d.Set("shared_key", conn.SharedKey)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmVirtualNetworkGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetGatewayConnectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	armClient := meta.(*ArmClient)
+	client := armClient.vnetGatewayConnectionsClientForSubscription(armClient.subscriptionIdFromResourceId(d.Id()))
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayConnectionFromId(d.Id())
 	if err != nil {
@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMVirtualNetworkGatewayConnection_sitetosite(t *testing.T) {
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMVirtualNetworkGatewayConnection_sitetosite(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -33,8 +32,8 @@ func TestAccAzureRMVirtualNetworkGatewayConnection_vnettonet(t *testing.T) {
 	firstResourceName := "azurerm_virtual_network_gateway_connection.test_1"
 	secondResourceName := "azurerm_virtual_network_gateway_connection.test_2"
 
-	ri := acctest.RandInt()
-	ri2 := acctest.RandInt()
+	ri := testAccRandInt(t)
+	ri2 := testAccRandInt(t)
 	sharedKey := "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
 	config := testAccAzureRMVirtualNetworkGatewayConnection_vnettovnet(ri, ri2, sharedKey, testLocation(), testAltLocation())
 
@@ -60,8 +59,8 @@ func TestAccAzureRMVirtualNetworkGatewayConnection_updatingSharedKey(t *testing.
 	firstResourceName := "azurerm_virtual_network_gateway_connection.test_1"
 	secondResourceName := "azurerm_virtual_network_gateway_connection.test_2"
 
-	ri := acctest.RandInt()
-	ri2 := acctest.RandInt()
+	ri := testAccRandInt(t)
+	ri2 := testAccRandInt(t)
 	loc1 := testLocation()
 	loc2 := testAltLocation()
 
@@ -102,7 +101,7 @@ func testCheckAzureRMVirtualNetworkGatewayConnectionExists(name string) resource
 			return err
 		}
 
-		client := testAccProvider.Meta().(*ArmClient).vnetGatewayConnectionsClient
+		client := testAccProvider.Meta().(*ArmClient).networking().vnetGatewayConnectionsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, name)
@@ -119,7 +118,7 @@ func testCheckAzureRMVirtualNetworkGatewayConnectionExists(name string) resource
 }
 
 func testCheckAzureRMVirtualNetworkGatewayConnectionDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).vnetGatewayConnectionsClient
+	client := testAccProvider.Meta().(*ArmClient).networking().vnetGatewayConnectionsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
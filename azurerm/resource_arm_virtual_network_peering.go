@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -14,14 +16,28 @@ import (
 // or deleted at the same time
 var peerMutex = &sync.Mutex{}
 
+var virtualNetworkPeeringIDFormat = resourceIDFormat{
+	description: "Virtual Network Peering",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "virtualNetworks", placeholder: "virtualNetworkName"},
+		{key: "virtualNetworkPeerings", placeholder: "peeringName"},
+	},
+}
+
 func resourceArmVirtualNetworkPeering() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmVirtualNetworkPeeringCreate,
-		Read:   resourceArmVirtualNetworkPeeringRead,
-		Update: resourceArmVirtualNetworkPeeringCreate,
-		Delete: resourceArmVirtualNetworkPeeringDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmVirtualNetworkPeeringCreate,
+		Read:     resourceArmVirtualNetworkPeeringRead,
+		Update:   resourceArmVirtualNetworkPeeringCreate,
+		Delete:   resourceArmVirtualNetworkPeeringDelete,
+		Importer: resourceIDImporter(virtualNetworkPeeringIDFormat),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -39,10 +55,19 @@ func resourceArmVirtualNetworkPeering() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"subscription_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateUUID,
+			},
+
 			"remote_virtual_network_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateVirtualNetworkID,
 			},
 
 			"allow_virtual_network_access": {
@@ -73,8 +98,14 @@ func resourceArmVirtualNetworkPeering() *schema.Resource {
 }
 
 func resourceArmVirtualNetworkPeeringCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetPeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	armClient := meta.(*ArmClient)
+	subscriptionId := armClient.subscriptionId
+	if v, ok := d.GetOk("subscription_id"); ok {
+		subscriptionId = v.(string)
+	}
+	client := armClient.vnetPeeringsClientForSubscription(subscriptionId)
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM virtual network peering creation.")
 
@@ -82,6 +113,17 @@ func resourceArmVirtualNetworkPeeringCreate(d *schema.ResourceData, meta interfa
 	vnetName := d.Get("virtual_network_name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
+	if requiresImport(d, meta) {
+		existing, err := client.Get(ctx, resGroup, vnetName, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing Virtual Network Peering %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return importAsExistsError("azurerm_virtual_network_peering", *existing.ID)
+		}
+	}
+
 	peer := network.VirtualNetworkPeering{
 		Name: &name,
 		VirtualNetworkPeeringPropertiesFormat: getVirtualNetworkPeeringProperties(d),
@@ -95,7 +137,7 @@ func resourceArmVirtualNetworkPeeringCreate(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("Error Creating/Updating Virtual Network Peering %q (Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
 	}
 
-	err = future.WaitForCompletion(ctx, client.Client)
+	err = waitForCreation(ctx, d, meta, future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for completion of Virtual Network Peering %q (Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
 	}
@@ -114,13 +156,14 @@ func resourceArmVirtualNetworkPeeringCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceArmVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetPeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
+	client := meta.(*ArmClient).vnetPeeringsClientForSubscription(id.SubscriptionID)
 	resGroup := id.ResourceGroup
 	vnetName := id.Path["virtualNetworks"]
 	name := id.Path["virtualNetworkPeerings"]
@@ -140,6 +183,7 @@ func resourceArmVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface
 	d.Set("resource_group_name", resGroup)
 	d.Set("name", resp.Name)
 	d.Set("virtual_network_name", vnetName)
+	d.Set("subscription_id", id.SubscriptionID)
 	d.Set("allow_virtual_network_access", peer.AllowVirtualNetworkAccess)
 	d.Set("allow_forwarded_traffic", peer.AllowForwardedTraffic)
 	d.Set("allow_gateway_transit", peer.AllowGatewayTransit)
@@ -150,13 +194,14 @@ func resourceArmVirtualNetworkPeeringRead(d *schema.ResourceData, meta interface
 }
 
 func resourceArmVirtualNetworkPeeringDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetPeeringsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
+	client := meta.(*ArmClient).vnetPeeringsClientForSubscription(id.SubscriptionID)
 	resGroup := id.ResourceGroup
 	vnetName := id.Path["virtualNetworks"]
 	name := id.Path["virtualNetworkPeerings"]
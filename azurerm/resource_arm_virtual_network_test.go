@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"testing"
 
-	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
@@ -32,7 +31,7 @@ func testSweepVirtualNetworks(region string) error {
 		return err
 	}
 
-	client := (*armClient).vnetClient
+	client := (*armClient).networking().vnetClient
 	ctx := (*armClient).StopContext
 
 	log.Printf("Retrieving the Virtual Networks..")
@@ -80,7 +79,7 @@ func testSweepVirtualNetworks(region string) error {
 
 func TestAccAzureRMVirtualNetwork_basic(t *testing.T) {
 	resourceName := "azurerm_virtual_network.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMVirtualNetwork_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -100,7 +99,7 @@ func TestAccAzureRMVirtualNetwork_basic(t *testing.T) {
 
 func TestAccAzureRMVirtualNetwork_disappears(t *testing.T) {
 	resourceName := "azurerm_virtual_network.test"
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	config := testAccAzureRMVirtualNetwork_basic(ri, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -123,7 +122,7 @@ func TestAccAzureRMVirtualNetwork_disappears(t *testing.T) {
 func TestAccAzureRMVirtualNetwork_withTags(t *testing.T) {
 	resourceName := "azurerm_virtual_network.test"
 	location := testLocation()
-	ri := acctest.RandInt()
+	ri := testAccRandInt(t)
 	preConfig := testAccAzureRMVirtualNetwork_withTags(ri, location)
 	postConfig := testAccAzureRMVirtualNetwork_withTagsUpdated(ri, location)
 
@@ -159,7 +158,7 @@ func TestAccAzureRMVirtualNetwork_withTags(t *testing.T) {
 
 func TestAccAzureRMVirtualNetwork_bug373(t *testing.T) {
 	resourceName := "azurerm_virtual_network.test"
-	rs := acctest.RandString(6)
+	rs := testAccRandString(t, 6)
 	config := testAccAzureRMVirtualNetwork_bug373(rs, testLocation())
 
 	resource.Test(t, resource.TestCase{
@@ -192,7 +191,7 @@ func testCheckAzureRMVirtualNetworkExists(name string) resource.TestCheckFunc {
 		}
 
 		// Ensure resource group/virtual network combination exists in API
-		client := testAccProvider.Meta().(*ArmClient).vnetClient
+		client := testAccProvider.Meta().(*ArmClient).networking().vnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup, virtualNetworkName, "")
@@ -223,7 +222,7 @@ func testCheckAzureRMVirtualNetworkDisappears(name string) resource.TestCheckFun
 		}
 
 		// Ensure resource group/virtual network combination exists in API
-		client := testAccProvider.Meta().(*ArmClient).vnetClient
+		client := testAccProvider.Meta().(*ArmClient).networking().vnetClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		future, err := client.Delete(ctx, resourceGroup, virtualNetworkName)
@@ -241,7 +240,7 @@ func testCheckAzureRMVirtualNetworkDisappears(name string) resource.TestCheckFun
 }
 
 func testCheckAzureRMVirtualNetworkDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).vnetClient
+	client := testAccProvider.Meta().(*ArmClient).networking().vnetClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
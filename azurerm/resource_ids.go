@@ -0,0 +1,977 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// resourceIDFormat describes the structure of the ID of a resource type within a Resource Group, such that IDs
+// of that type can be parsed (and validated) without indexing into the Path of a ResourceID by hand.
+type resourceIDFormat struct {
+	// description is the type of resource used in error messages, e.g. `Subnet`
+	description string
+
+	// provider is the Resource Provider the resource type belongs to - which is empty for a Resource Group
+	provider string
+	segments []resourceIDSegment
+}
+
+// resourceIDSegment is the type of a (nested) resource within an ID, e.g. `subnets` - along with the
+// placeholder used for the name of the resource when displaying the format
+type resourceIDSegment struct {
+	key         string
+	placeholder string
+
+	// value is the fixed name of a segment which can only have a single value (e.g. the `default` Blob
+	// Service within a Storage Account) - in which case no placeholder is used
+	value string
+}
+
+// parsedResourceID contains the components of an ID which matched a resourceIDFormat
+type parsedResourceID struct {
+	subscriptionId string
+	resourceGroup  string
+
+	// names contains the name of the resource for each segment of the format, in order
+	names []string
+}
+
+// String returns the format of the ID, e.g. `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/...`
+func (f resourceIDFormat) String() string {
+	names := make([]string, 0, len(f.segments))
+	for _, segment := range f.segments {
+		if segment.value == "" {
+			names = append(names, fmt.Sprintf("{%s}", segment.placeholder))
+		}
+	}
+
+	return f.format("{subscriptionId}", "{resourceGroupName}", names...)
+}
+
+// format builds an ID of this type from the Subscription ID, Resource Group and the names of each segment
+func (f resourceIDFormat) format(subscriptionId, resourceGroup string, names ...string) string {
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionId, resourceGroup)
+	if f.provider != "" {
+		id += fmt.Sprintf("/providers/%s", f.provider)
+	}
+
+	for _, segment := range f.segments {
+		name := segment.value
+		if name == "" {
+			name, names = names[0], names[1:]
+		}
+		id += fmt.Sprintf("/%s/%s", segment.key, name)
+	}
+
+	return id
+}
+
+// parse parses the ID, returning an error if it isn't an ID of this type. Since Azure isn't consistent with
+// the casing of the keys (for example `resourcegroups`) these are compared case-insensitively.
+func (f resourceIDFormat) parse(input string) (*parsedResourceID, error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse %q as a %s ID: %+v", input, f.description, err)
+	}
+
+	// an empty value in the expected components is the name of a resource
+	components := strings.Split(strings.Trim(idURL.Path, "/"), "/")
+	expected := []string{"subscriptions", "", "resourceGroups", ""}
+	if f.provider != "" {
+		expected = append(expected, "providers", f.provider)
+	}
+	for _, segment := range f.segments {
+		expected = append(expected, segment.key, segment.value)
+	}
+
+	if len(components) != len(expected) {
+		return nil, fmt.Errorf("Expected %q to be a %s ID in the format %q", input, f.description, f.String())
+	}
+
+	names := make([]string, 0, len(f.segments))
+	for i, key := range expected {
+		value := components[i]
+		if value == "" {
+			return nil, fmt.Errorf("Expected %q to be a %s ID in the format %q but segment %d was empty", input, f.description, f.String(), i+1)
+		}
+
+		if key == "" {
+			names = append(names, value)
+			continue
+		}
+
+		if !strings.EqualFold(key, value) {
+			return nil, fmt.Errorf("Expected %q to be a %s ID in the format %q but got %q rather than %q", input, f.description, f.String(), value, key)
+		}
+	}
+
+	return &parsedResourceID{
+		subscriptionId: names[0],
+		resourceGroup:  names[1],
+		names:          names[2:],
+	}, nil
+}
+
+// validate is a SchemaValidateFunc which checks the value is an ID of this type
+func (f resourceIDFormat) validate(i interface{}, k string) (ws []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := f.parse(v); err != nil {
+		es = append(es, fmt.Errorf("%q is invalid: %+v", k, err))
+	}
+
+	return
+}
+
+// validateResourceID validates that the value is an Azure Resource ID, for fields which can reference
+// more than one type of resource
+func validateResourceID(i interface{}, k string) (ws []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := parseAzureResourceID(v); err != nil {
+		es = append(es, fmt.Errorf("Cannot parse %q as an Azure Resource ID: %+v", k, err))
+	}
+
+	return
+}
+
+var virtualNetworkIDFormat = resourceIDFormat{
+	description: "Virtual Network",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "virtualNetworks", placeholder: "virtualNetworkName"},
+	},
+}
+
+// VirtualNetworkID is the parsed ID of a Virtual Network
+type VirtualNetworkID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseVirtualNetworkID(input string) (*VirtualNetworkID, error) {
+	id, err := virtualNetworkIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the Virtual Network ID in the canonical format
+func (id VirtualNetworkID) ID() string {
+	return virtualNetworkIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateVirtualNetworkID(i interface{}, k string) ([]string, []error) {
+	return virtualNetworkIDFormat.validate(i, k)
+}
+
+var subnetIDFormat = resourceIDFormat{
+	description: "Subnet",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "virtualNetworks", placeholder: "virtualNetworkName"},
+		{key: "subnets", placeholder: "subnetName"},
+	},
+}
+
+// SubnetID is the parsed ID of a Subnet
+type SubnetID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	VirtualNetworkName string
+	Name               string
+}
+
+func parseSubnetID(input string) (*SubnetID, error) {
+	id, err := subnetIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubnetID{
+		SubscriptionID:     id.subscriptionId,
+		ResourceGroup:      id.resourceGroup,
+		VirtualNetworkName: id.names[0],
+		Name:               id.names[1],
+	}, nil
+}
+
+// ID returns the Subnet ID in the canonical format
+func (id SubnetID) ID() string {
+	return subnetIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+}
+
+func validateSubnetID(i interface{}, k string) ([]string, []error) {
+	return subnetIDFormat.validate(i, k)
+}
+
+var networkSecurityGroupIDFormat = resourceIDFormat{
+	description: "Network Security Group",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "networkSecurityGroups", placeholder: "networkSecurityGroupName"},
+	},
+}
+
+// NetworkSecurityGroupID is the parsed ID of a Network Security Group
+type NetworkSecurityGroupID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseNetworkSecurityGroupID(input string) (*NetworkSecurityGroupID, error) {
+	id, err := networkSecurityGroupIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkSecurityGroupID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the Network Security Group ID in the canonical format
+func (id NetworkSecurityGroupID) ID() string {
+	return networkSecurityGroupIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateNetworkSecurityGroupID(i interface{}, k string) ([]string, []error) {
+	return networkSecurityGroupIDFormat.validate(i, k)
+}
+
+var routeTableIDFormat = resourceIDFormat{
+	description: "Route Table",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "routeTables", placeholder: "routeTableName"},
+	},
+}
+
+// RouteTableID is the parsed ID of a Route Table
+type RouteTableID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseRouteTableID(input string) (*RouteTableID, error) {
+	id, err := routeTableIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RouteTableID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the Route Table ID in the canonical format
+func (id RouteTableID) ID() string {
+	return routeTableIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateRouteTableID(i interface{}, k string) ([]string, []error) {
+	return routeTableIDFormat.validate(i, k)
+}
+
+var publicIPAddressIDFormat = resourceIDFormat{
+	description: "Public IP Address",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "publicIPAddresses", placeholder: "publicIPAddressName"},
+	},
+}
+
+// PublicIPAddressID is the parsed ID of a Public IP Address
+type PublicIPAddressID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parsePublicIPAddressID(input string) (*PublicIPAddressID, error) {
+	id, err := publicIPAddressIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PublicIPAddressID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the Public IP Address ID in the canonical format
+func (id PublicIPAddressID) ID() string {
+	return publicIPAddressIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validatePublicIPAddressID(i interface{}, k string) ([]string, []error) {
+	return publicIPAddressIDFormat.validate(i, k)
+}
+
+var networkInterfaceIDFormat = resourceIDFormat{
+	description: "Network Interface",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "networkInterfaces", placeholder: "networkInterfaceName"},
+	},
+}
+
+// NetworkInterfaceID is the parsed ID of a Network Interface
+type NetworkInterfaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseNetworkInterfaceID(input string) (*NetworkInterfaceID, error) {
+	id, err := networkInterfaceIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkInterfaceID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the Network Interface ID in the canonical format
+func (id NetworkInterfaceID) ID() string {
+	return networkInterfaceIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateNetworkInterfaceID(i interface{}, k string) ([]string, []error) {
+	return networkInterfaceIDFormat.validate(i, k)
+}
+
+var loadBalancerIDFormat = resourceIDFormat{
+	description: "Load Balancer",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "loadBalancers", placeholder: "loadBalancerName"},
+	},
+}
+
+// LoadBalancerID is the parsed ID of a Load Balancer
+type LoadBalancerID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseLoadBalancerID(input string) (*LoadBalancerID, error) {
+	id, err := loadBalancerIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the Load Balancer ID in the canonical format
+func (id LoadBalancerID) ID() string {
+	return loadBalancerIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateLoadBalancerID(i interface{}, k string) ([]string, []error) {
+	return loadBalancerIDFormat.validate(i, k)
+}
+
+var loadBalancerBackendAddressPoolIDFormat = resourceIDFormat{
+	description: "Load Balancer Backend Address Pool",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "loadBalancers", placeholder: "loadBalancerName"},
+		{key: "backendAddressPools", placeholder: "backendAddressPoolName"},
+	},
+}
+
+// LoadBalancerBackendAddressPoolID is the parsed ID of a Load Balancer Backend Address Pool
+type LoadBalancerBackendAddressPoolID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func parseLoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddressPoolID, error) {
+	id, err := loadBalancerBackendAddressPoolIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerBackendAddressPoolID{
+		SubscriptionID:   id.subscriptionId,
+		ResourceGroup:    id.resourceGroup,
+		LoadBalancerName: id.names[0],
+		Name:             id.names[1],
+	}, nil
+}
+
+// ID returns the Load Balancer Backend Address Pool ID in the canonical format
+func (id LoadBalancerBackendAddressPoolID) ID() string {
+	return loadBalancerBackendAddressPoolIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+func validateLoadBalancerBackendAddressPoolID(i interface{}, k string) ([]string, []error) {
+	return loadBalancerBackendAddressPoolIDFormat.validate(i, k)
+}
+
+var loadBalancerInboundNatRuleIDFormat = resourceIDFormat{
+	description: "Load Balancer Inbound NAT Rule",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "loadBalancers", placeholder: "loadBalancerName"},
+		{key: "inboundNatRules", placeholder: "inboundNatRuleName"},
+	},
+}
+
+// LoadBalancerInboundNatRuleID is the parsed ID of a Load Balancer Inbound NAT Rule
+type LoadBalancerInboundNatRuleID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func parseLoadBalancerInboundNatRuleID(input string) (*LoadBalancerInboundNatRuleID, error) {
+	id, err := loadBalancerInboundNatRuleIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerInboundNatRuleID{
+		SubscriptionID:   id.subscriptionId,
+		ResourceGroup:    id.resourceGroup,
+		LoadBalancerName: id.names[0],
+		Name:             id.names[1],
+	}, nil
+}
+
+// ID returns the Load Balancer Inbound NAT Rule ID in the canonical format
+func (id LoadBalancerInboundNatRuleID) ID() string {
+	return loadBalancerInboundNatRuleIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+func validateLoadBalancerInboundNatRuleID(i interface{}, k string) ([]string, []error) {
+	return loadBalancerInboundNatRuleIDFormat.validate(i, k)
+}
+
+var loadBalancerInboundNatPoolIDFormat = resourceIDFormat{
+	description: "Load Balancer Inbound NAT Pool",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "loadBalancers", placeholder: "loadBalancerName"},
+		{key: "inboundNatPools", placeholder: "inboundNatPoolName"},
+	},
+}
+
+// LoadBalancerInboundNatPoolID is the parsed ID of a Load Balancer Inbound NAT Pool
+type LoadBalancerInboundNatPoolID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func parseLoadBalancerInboundNatPoolID(input string) (*LoadBalancerInboundNatPoolID, error) {
+	id, err := loadBalancerInboundNatPoolIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerInboundNatPoolID{
+		SubscriptionID:   id.subscriptionId,
+		ResourceGroup:    id.resourceGroup,
+		LoadBalancerName: id.names[0],
+		Name:             id.names[1],
+	}, nil
+}
+
+// ID returns the Load Balancer Inbound NAT Pool ID in the canonical format
+func (id LoadBalancerInboundNatPoolID) ID() string {
+	return loadBalancerInboundNatPoolIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+func validateLoadBalancerInboundNatPoolID(i interface{}, k string) ([]string, []error) {
+	return loadBalancerInboundNatPoolIDFormat.validate(i, k)
+}
+
+var loadBalancerProbeIDFormat = resourceIDFormat{
+	description: "Load Balancer Probe",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "loadBalancers", placeholder: "loadBalancerName"},
+		{key: "probes", placeholder: "probeName"},
+	},
+}
+
+// LoadBalancerProbeID is the parsed ID of a Load Balancer Probe
+type LoadBalancerProbeID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+func parseLoadBalancerProbeID(input string) (*LoadBalancerProbeID, error) {
+	id, err := loadBalancerProbeIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerProbeID{
+		SubscriptionID:   id.subscriptionId,
+		ResourceGroup:    id.resourceGroup,
+		LoadBalancerName: id.names[0],
+		Name:             id.names[1],
+	}, nil
+}
+
+// ID returns the Load Balancer Probe ID in the canonical format
+func (id LoadBalancerProbeID) ID() string {
+	return loadBalancerProbeIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+func validateLoadBalancerProbeID(i interface{}, k string) ([]string, []error) {
+	return loadBalancerProbeIDFormat.validate(i, k)
+}
+
+var virtualNetworkGatewayIDFormat = resourceIDFormat{
+	description: "Virtual Network Gateway",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "virtualNetworkGateways", placeholder: "virtualNetworkGatewayName"},
+	},
+}
+
+// VirtualNetworkGatewayID is the parsed ID of a Virtual Network Gateway
+type VirtualNetworkGatewayID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseVirtualNetworkGatewayID(input string) (*VirtualNetworkGatewayID, error) {
+	id, err := virtualNetworkGatewayIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkGatewayID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the Virtual Network Gateway ID in the canonical format
+func (id VirtualNetworkGatewayID) ID() string {
+	return virtualNetworkGatewayIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateVirtualNetworkGatewayID(i interface{}, k string) ([]string, []error) {
+	return virtualNetworkGatewayIDFormat.validate(i, k)
+}
+
+var localNetworkGatewayIDFormat = resourceIDFormat{
+	description: "Local Network Gateway",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "localNetworkGateways", placeholder: "localNetworkGatewayName"},
+	},
+}
+
+// LocalNetworkGatewayID is the parsed ID of a Local Network Gateway
+type LocalNetworkGatewayID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseLocalNetworkGatewayID(input string) (*LocalNetworkGatewayID, error) {
+	id, err := localNetworkGatewayIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalNetworkGatewayID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the Local Network Gateway ID in the canonical format
+func (id LocalNetworkGatewayID) ID() string {
+	return localNetworkGatewayIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateLocalNetworkGatewayID(i interface{}, k string) ([]string, []error) {
+	return localNetworkGatewayIDFormat.validate(i, k)
+}
+
+var expressRouteCircuitIDFormat = resourceIDFormat{
+	description: "ExpressRoute Circuit",
+	provider:    "Microsoft.Network",
+	segments: []resourceIDSegment{
+		{key: "expressRouteCircuits", placeholder: "circuitName"},
+	},
+}
+
+// ExpressRouteCircuitID is the parsed ID of a ExpressRoute Circuit
+type ExpressRouteCircuitID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseExpressRouteCircuitID(input string) (*ExpressRouteCircuitID, error) {
+	id, err := expressRouteCircuitIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExpressRouteCircuitID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the ExpressRoute Circuit ID in the canonical format
+func (id ExpressRouteCircuitID) ID() string {
+	return expressRouteCircuitIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateExpressRouteCircuitID(i interface{}, k string) ([]string, []error) {
+	return expressRouteCircuitIDFormat.validate(i, k)
+}
+
+var availabilitySetIDFormat = resourceIDFormat{
+	description: "Availability Set",
+	provider:    "Microsoft.Compute",
+	segments: []resourceIDSegment{
+		{key: "availabilitySets", placeholder: "availabilitySetName"},
+	},
+}
+
+// AvailabilitySetID is the parsed ID of a Availability Set
+type AvailabilitySetID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseAvailabilitySetID(input string) (*AvailabilitySetID, error) {
+	id, err := availabilitySetIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AvailabilitySetID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the Availability Set ID in the canonical format
+func (id AvailabilitySetID) ID() string {
+	return availabilitySetIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateAvailabilitySetID(i interface{}, k string) ([]string, []error) {
+	return availabilitySetIDFormat.validate(i, k)
+}
+
+var managedDiskIDFormat = resourceIDFormat{
+	description: "Managed Disk",
+	provider:    "Microsoft.Compute",
+	segments: []resourceIDSegment{
+		{key: "disks", placeholder: "diskName"},
+	},
+}
+
+// ManagedDiskID is the parsed ID of a Managed Disk
+type ManagedDiskID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseManagedDiskID(input string) (*ManagedDiskID, error) {
+	id, err := managedDiskIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagedDiskID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the Managed Disk ID in the canonical format
+func (id ManagedDiskID) ID() string {
+	return managedDiskIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateManagedDiskID(i interface{}, k string) ([]string, []error) {
+	return managedDiskIDFormat.validate(i, k)
+}
+
+var virtualMachineIDFormat = resourceIDFormat{
+	description: "Virtual Machine",
+	provider:    "Microsoft.Compute",
+	segments: []resourceIDSegment{
+		{key: "virtualMachines", placeholder: "virtualMachineName"},
+	},
+}
+
+// VirtualMachineID is the parsed ID of a Virtual Machine
+type VirtualMachineID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseVirtualMachineID(input string) (*VirtualMachineID, error) {
+	id, err := virtualMachineIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the Virtual Machine ID in the canonical format
+func (id VirtualMachineID) ID() string {
+	return virtualMachineIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateVirtualMachineID(i interface{}, k string) ([]string, []error) {
+	return virtualMachineIDFormat.validate(i, k)
+}
+
+var storageAccountIDFormat = resourceIDFormat{
+	description: "Storage Account",
+	provider:    "Microsoft.Storage",
+	segments: []resourceIDSegment{
+		{key: "storageAccounts", placeholder: "storageAccountName"},
+	},
+}
+
+// StorageAccountID is the parsed ID of a Storage Account
+type StorageAccountID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseStorageAccountID(input string) (*StorageAccountID, error) {
+	id, err := storageAccountIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageAccountID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the Storage Account ID in the canonical format
+func (id StorageAccountID) ID() string {
+	return storageAccountIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateStorageAccountID(i interface{}, k string) ([]string, []error) {
+	return storageAccountIDFormat.validate(i, k)
+}
+
+var appServicePlanIDFormat = resourceIDFormat{
+	description: "App Service Plan",
+	provider:    "Microsoft.Web",
+	segments: []resourceIDSegment{
+		{key: "serverfarms", placeholder: "appServicePlanName"},
+	},
+}
+
+// AppServicePlanID is the parsed ID of a App Service Plan
+type AppServicePlanID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseAppServicePlanID(input string) (*AppServicePlanID, error) {
+	id, err := appServicePlanIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppServicePlanID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the App Service Plan ID in the canonical format
+func (id AppServicePlanID) ID() string {
+	return appServicePlanIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateAppServicePlanID(i interface{}, k string) ([]string, []error) {
+	return appServicePlanIDFormat.validate(i, k)
+}
+
+var appServiceEnvironmentIDFormat = resourceIDFormat{
+	description: "App Service Environment",
+	provider:    "Microsoft.Web",
+	segments: []resourceIDSegment{
+		{key: "hostingEnvironments", placeholder: "appServiceEnvironmentName"},
+	},
+}
+
+// AppServiceEnvironmentID is the parsed ID of a App Service Environment
+type AppServiceEnvironmentID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseAppServiceEnvironmentID(input string) (*AppServiceEnvironmentID, error) {
+	id, err := appServiceEnvironmentIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppServiceEnvironmentID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the App Service Environment ID in the canonical format
+func (id AppServiceEnvironmentID) ID() string {
+	return appServiceEnvironmentIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateAppServiceEnvironmentID(i interface{}, k string) ([]string, []error) {
+	return appServiceEnvironmentIDFormat.validate(i, k)
+}
+
+var keyVaultIDFormat = resourceIDFormat{
+	description: "Key Vault",
+	provider:    "Microsoft.KeyVault",
+	segments: []resourceIDSegment{
+		{key: "vaults", placeholder: "vaultName"},
+	},
+}
+
+// KeyVaultID is the parsed ID of a Key Vault
+type KeyVaultID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+func parseKeyVaultID(input string) (*KeyVaultID, error) {
+	id, err := keyVaultIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyVaultID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		Name:           id.names[0],
+	}, nil
+}
+
+// ID returns the Key Vault ID in the canonical format
+func (id KeyVaultID) ID() string {
+	return keyVaultIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+func validateKeyVaultID(i interface{}, k string) ([]string, []error) {
+	return keyVaultIDFormat.validate(i, k)
+}
+
+var sqlDatabaseIDFormat = resourceIDFormat{
+	description: "SQL Database",
+	provider:    "Microsoft.Sql",
+	segments: []resourceIDSegment{
+		{key: "servers", placeholder: "serverName"},
+		{key: "databases", placeholder: "databaseName"},
+	},
+}
+
+// SqlDatabaseID is the parsed ID of a SQL Database
+type SqlDatabaseID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+func parseSqlDatabaseID(input string) (*SqlDatabaseID, error) {
+	id, err := sqlDatabaseIDFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlDatabaseID{
+		SubscriptionID: id.subscriptionId,
+		ResourceGroup:  id.resourceGroup,
+		ServerName:     id.names[0],
+		Name:           id.names[1],
+	}, nil
+}
+
+// ID returns the SQL Database ID in the canonical format
+func (id SqlDatabaseID) ID() string {
+	return sqlDatabaseIDFormat.format(id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+func validateSqlDatabaseID(i interface{}, k string) ([]string, []error) {
+	return sqlDatabaseIDFormat.validate(i, k)
+}
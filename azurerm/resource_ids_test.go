@@ -0,0 +1,198 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSubnetID(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected *SubnetID
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Expected: nil,
+		},
+		{
+			// a Virtual Network rather than a Subnet
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: nil,
+		},
+		{
+			// a Network Security Group rather than a Subnet
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/networkSecurityGroups/group1/subnets/subnet1",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualNetworks/network1/subnets/subnet1",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks//subnets/subnet1",
+			Expected: nil,
+		},
+		{
+			// a nested resource within the Subnet
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1/serviceAssociationLinks/link1",
+			Expected: nil,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: &SubnetID{
+				SubscriptionID:     "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:      "example",
+				VirtualNetworkName: "network1",
+				Name:               "subnet1",
+			},
+		},
+		{
+			// Azure returns some IDs with different casing
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/example/providers/microsoft.network/virtualnetworks/network1/subnets/subnet1/",
+			Expected: &SubnetID{
+				SubscriptionID:     "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:      "example",
+				VirtualNetworkName: "network1",
+				Name:               "subnet1",
+			},
+		},
+	}
+
+	for _, v := range testCases {
+		actual, err := parseSubnetID(v.Input)
+		if v.Expected == nil {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q but didn't get one", v.Input)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error parsing %q but got: %+v", v.Input, err)
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %q to be parsed as %+v but got %+v", v.Input, v.Expected, actual)
+		}
+	}
+}
+
+func TestResourceIDFormat(t *testing.T) {
+	id := SqlDatabaseID{
+		SubscriptionID: "00000000-0000-0000-0000-000000000000",
+		ResourceGroup:  "example",
+		ServerName:     "server1",
+		Name:           "database1",
+	}
+
+	expected := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Sql/servers/server1/databases/database1"
+	if actual := id.ID(); actual != expected {
+		t.Fatalf("Expected the ID to be %q but got %q", expected, actual)
+	}
+
+	parsed, err := parseSqlDatabaseID(id.ID())
+	if err != nil {
+		t.Fatalf("Expected no error parsing %q but got: %+v", id.ID(), err)
+	}
+	if *parsed != id {
+		t.Fatalf("Expected the parsed ID to be %+v but got %+v", id, *parsed)
+	}
+
+	expectedFormat := "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Sql/servers/{serverName}/databases/{databaseName}"
+	if actual := sqlDatabaseIDFormat.String(); actual != expectedFormat {
+		t.Fatalf("Expected the format to be %q but got %q", expectedFormat, actual)
+	}
+}
+
+func TestValidateResourceIDs(t *testing.T) {
+	testCases := []struct {
+		Input    interface{}
+		Validate func(interface{}, string) ([]string, []error)
+		Errors   int
+	}{
+		{
+			Input:    1,
+			Validate: validateNetworkSecurityGroupID,
+			Errors:   1,
+		},
+		{
+			Input:    "example",
+			Validate: validateNetworkSecurityGroupID,
+			Errors:   1,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/routeTables/table1",
+			Validate: validateNetworkSecurityGroupID,
+			Errors:   1,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/networkSecurityGroups/group1",
+			Validate: validateNetworkSecurityGroupID,
+			Errors:   0,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool1",
+			Validate: validateLoadBalancerProbeID,
+			Errors:   1,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool1",
+			Validate: validateLoadBalancerBackendAddressPoolID,
+			Errors:   0,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Web/serverfarms/plan1",
+			Validate: validateAppServicePlanID,
+			Errors:   0,
+		},
+		{
+			Input:    "example",
+			Validate: validateResourceID,
+			Errors:   1,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/publicIPAddresses/ip1",
+			Validate: validateResourceID,
+			Errors:   0,
+		},
+	}
+
+	for _, v := range testCases {
+		_, errors := v.Validate(v.Input, "example_id")
+		if len(errors) != v.Errors {
+			t.Fatalf("Expected %d error(s) validating %q but got %d: %+v", v.Errors, v.Input, len(errors), errors)
+		}
+	}
+}
+
+func TestValidateArmVirtualNetworkGatewaySubnetId(t *testing.T) {
+	testCases := []struct {
+		Input  string
+		Errors int
+	}{
+		{
+			Input:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1",
+			Errors: 1,
+		},
+		{
+			Input:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Errors: 1,
+		},
+		{
+			Input:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1/subnets/GatewaySubnet",
+			Errors: 0,
+		},
+	}
+
+	for _, v := range testCases {
+		_, errors := validateArmVirtualNetworkGatewaySubnetId(v.Input, "subnet_id")
+		if len(errors) != v.Errors {
+			t.Fatalf("Expected %d error(s) validating %q but got %d: %+v", v.Errors, v.Input, len(errors), errors)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// validatingImporter returns an Importer which imports the ID as-is once it's been validated - since otherwise
+// importing the ID of another type of resource (for example a Network Security Group ID as a Subnet) succeeds,
+// and then either fails or writes the wrong resource into the state on the next Read.
+func validatingImporter(validate func(id string) error) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if err := validate(d.Id()); err != nil {
+				return nil, fmt.Errorf("Error importing %q: %+v", d.Id(), err)
+			}
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// resourceIDImporter returns an Importer which validates the ID being imported matches one of the formats
+func resourceIDImporter(formats ...resourceIDFormat) *schema.ResourceImporter {
+	return validatingImporter(func(id string) error {
+		return validateResourceIDFormats(id, formats...)
+	})
+}
+
+func validateResourceIDFormats(id string, formats ...resourceIDFormat) error {
+	if len(formats) == 1 {
+		_, err := formats[0].parse(id)
+		return err
+	}
+
+	expected := make([]string, 0, len(formats))
+	for _, format := range formats {
+		if _, err := format.parse(id); err == nil {
+			return nil
+		}
+
+		expected = append(expected, fmt.Sprintf("%q", format.String()))
+	}
+
+	return fmt.Errorf("Expected %q to be a %s ID in one of the formats %s", id, formats[0].description, strings.Join(expected, ", "))
+}
+
+// scopedResourceIDFormat describes the ID of a resource type which can be created at any scope - for example a
+// Management Lock can be created on a Subscription, a Resource Group or an individual Resource
+type scopedResourceIDFormat struct {
+	description string
+	provider    string
+	segment     resourceIDSegment
+}
+
+// String returns the format of the ID, e.g. `{scope}/providers/Microsoft.Authorization/locks/{lockName}`
+func (f scopedResourceIDFormat) String() string {
+	return fmt.Sprintf("{scope}/providers/%s/%s/{%s}", f.provider, f.segment.key, f.segment.placeholder)
+}
+
+// parse parses the ID into the scope and the name of the resource, returning an error if it isn't an ID of this type
+func (f scopedResourceIDFormat) parse(input string) (scope string, name string, err error) {
+	if _, err := url.ParseRequestURI(input); err != nil {
+		return "", "", fmt.Errorf("Cannot parse %q as a %s ID: %+v", input, f.description, err)
+	}
+
+	separator := fmt.Sprintf("/providers/%s/%s/", f.provider, f.segment.key)
+	index := strings.LastIndex(strings.ToLower(input), strings.ToLower(separator))
+	if index == -1 {
+		return "", "", fmt.Errorf("Expected %q to be a %s ID in the format %q", input, f.description, f.String())
+	}
+
+	scope = input[:index]
+	name = strings.TrimSuffix(input[index+len(separator):], "/")
+	if name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf("Expected %q to be a %s ID in the format %q", input, f.description, f.String())
+	}
+
+	// the scope is at least a Subscription, e.g. `/subscriptions/{subscriptionId}`
+	components := strings.Split(strings.TrimPrefix(scope, "/"), "/")
+	if len(components) < 2 || !strings.EqualFold(components[0], "subscriptions") || components[1] == "" {
+		return "", "", fmt.Errorf("Expected the scope of the %s ID %q to be a Subscription, Resource Group or Resource but got %q", f.description, input, scope)
+	}
+
+	return scope, name, nil
+}
+
+// scopedResourceIDImporter returns an Importer which validates the ID being imported matches the format
+func scopedResourceIDImporter(format scopedResourceIDFormat) *schema.ResourceImporter {
+	return validatingImporter(func(id string) error {
+		_, _, err := format.parse(id)
+		return err
+	})
+}
+
+// storageResourceImporter returns an Importer for a resource within a Storage Account (for example a Queue) whose ID
+// is its name - as such it's imported using an ID in the specified format, from which the name of the resource and
+// the Storage Account are set. populate (if specified) sets any fields which the Read function doesn't.
+func storageResourceImporter(format resourceIDFormat, populate func(d *schema.ResourceData, meta interface{}) error) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			id, err := format.parse(d.Id())
+			if err != nil {
+				return nil, fmt.Errorf("Error importing %q: %+v", d.Id(), err)
+			}
+
+			name := id.names[1]
+			d.SetId(name)
+			d.Set("name", name)
+			d.Set("resource_group_name", id.resourceGroup)
+			d.Set("storage_account_name", id.names[0])
+
+			if populate != nil {
+				if err := populate(d, meta); err != nil {
+					return nil, err
+				}
+			}
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
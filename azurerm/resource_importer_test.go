@@ -0,0 +1,202 @@
+package azurerm
+
+import (
+	"testing"
+)
+
+func TestProvider_importersValidateTheID(t *testing.T) {
+	provider := Provider().(*armProvider)
+
+	resourceGroupId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"
+	virtualNetworkId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1"
+
+	for name, resource := range provider.ResourcesMap {
+		if resource.Importer == nil {
+			t.Fatalf("Expected %q to be importable but it doesn't have an Importer", name)
+		}
+
+		// the generic resource can be any type of resource
+		if name == "azurerm_resource" {
+			continue
+		}
+
+		// none of the resources can be imported using the ID of a Virtual Network other than the Virtual Network
+		invalidId := virtualNetworkId
+		if name == "azurerm_virtual_network" {
+			invalidId = resourceGroupId
+		}
+
+		d := resource.TestResourceData()
+		d.SetId(invalidId)
+		if _, err := resource.Importer.State(d, nil); err == nil {
+			t.Fatalf("Expected an error importing %q as %q but didn't get one", invalidId, name)
+		}
+	}
+}
+
+func TestProvider_importersAcceptTheID(t *testing.T) {
+	provider := Provider().(*armProvider)
+
+	testCases := []struct {
+		ResourceType string
+		ID           string
+		Errors       bool
+	}{
+		{
+			ResourceType: "azurerm_resource_group",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+		},
+		{
+			ResourceType: "azurerm_subnet",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			ResourceType: "azurerm_subnet",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/networkSecurityGroups/group1",
+			Errors:       true,
+		},
+		{
+			// Azure returns the ID of a DNS Zone with a different casing
+			ResourceType: "azurerm_dns_a_record",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/dnszones/example.com/A/www",
+		},
+		{
+			ResourceType: "azurerm_dns_a_record",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/dnszones/example.com/AAAA/www",
+			Errors:       true,
+		},
+		{
+			ResourceType: "azurerm_traffic_manager_endpoint",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/trafficManagerProfiles/profile1/externalEndpoints/endpoint1",
+		},
+		{
+			ResourceType: "azurerm_traffic_manager_endpoint",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/trafficManagerProfiles/profile1",
+			Errors:       true,
+		},
+		{
+			ResourceType: "azurerm_redis_firewall_rule",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Cache/Redis/cache1/firewallRules/rule1",
+		},
+		{
+			ResourceType: "azurerm_template_deployment",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Resources/deployments/deployment1",
+		},
+		{
+			ResourceType: "azurerm_lb_rule",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/loadBalancers/lb1/loadBalancingRules/rule1",
+		},
+		{
+			ResourceType: "azurerm_lb_rule",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/loadBalancers/lb1/probes/probe1",
+			Errors:       true,
+		},
+		{
+			ResourceType: "azurerm_management_lock",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Authorization/locks/lock1",
+		},
+		{
+			ResourceType: "azurerm_role_assignment",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000",
+		},
+		{
+			ResourceType: "azurerm_role_assignment",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/00000000-0000-0000-0000-000000000000",
+			Errors:       true,
+		},
+		{
+			ResourceType: "azurerm_key_vault_secret",
+			ID:           "https://example.vault.azure.net/secrets/secret1/fdf067c93bbb4b22bff4d8b7a9a56217",
+		},
+		{
+			ResourceType: "azurerm_key_vault_secret",
+			ID:           "https://example.vault.azure.net/keys/key1/fdf067c93bbb4b22bff4d8b7a9a56217",
+			Errors:       true,
+		},
+		{
+			ResourceType: "azurerm_storage_queue",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/account1/queueServices/default/queues/queue1",
+		},
+		{
+			ResourceType: "azurerm_storage_queue",
+			ID:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/account1/queueServices/other/queues/queue1",
+			Errors:       true,
+		},
+	}
+
+	for _, v := range testCases {
+		resource := provider.ResourcesMap[v.ResourceType]
+
+		d := resource.TestResourceData()
+		d.SetId(v.ID)
+		_, err := resource.Importer.State(d, nil)
+		if v.Errors && err == nil {
+			t.Fatalf("Expected an error importing %q as %q but didn't get one", v.ID, v.ResourceType)
+		}
+		if !v.Errors && err != nil {
+			t.Fatalf("Expected no error importing %q as %q but got: %+v", v.ID, v.ResourceType, err)
+		}
+	}
+
+	// the Storage Queue is imported using its name
+	resource := provider.ResourcesMap["azurerm_storage_queue"]
+	d := resource.TestResourceData()
+	d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/account1/queueServices/default/queues/queue1")
+	if _, err := resource.Importer.State(d, nil); err != nil {
+		t.Fatalf("Expected no error importing the Storage Queue but got: %+v", err)
+	}
+	if d.Id() != "queue1" || d.Get("storage_account_name").(string) != "account1" || d.Get("resource_group_name").(string) != "example" {
+		t.Fatalf("Expected the Storage Queue to be imported as %q in %q/%q but got %q in %q/%q", "queue1", "example", "account1",
+			d.Id(), d.Get("resource_group_name").(string), d.Get("storage_account_name").(string))
+	}
+}
+
+func TestScopedResourceIDFormat(t *testing.T) {
+	testCases := []struct {
+		Input string
+		Scope string
+		Name  string
+	}{
+		{
+			Input: "",
+		},
+		{
+			Input: "/providers/Microsoft.Authorization/locks/lock1",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/locks/",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/locks/lock1/other",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/locks/lock1",
+			Scope: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Name:  "lock1",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1/providers/microsoft.authorization/locks/lock1",
+			Scope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1",
+			Name:  "lock1",
+		},
+	}
+
+	for _, v := range testCases {
+		scope, name, err := managementLockIDFormat.parse(v.Input)
+		if v.Name == "" {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q but didn't get one", v.Input)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error parsing %q but got: %+v", v.Input, err)
+		}
+
+		if scope != v.Scope || name != v.Name {
+			t.Fatalf("Expected %q to be parsed as %q / %q but got %q / %q", v.Input, v.Scope, v.Name, scope, name)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/mutexkv"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// resourceProviderRegistrationTimeout is how long we wait for a Resource Provider to finish registering
+const resourceProviderRegistrationTimeout = 15 * time.Minute
+
+// resourceProviderRegistration registers Resource Providers (e.g. `Microsoft.Compute`) with the Subscription,
+// such that only the Resource Providers actually used by the configuration need to be registered.
+type resourceProviderRegistration struct {
+	client         resources.ProvidersClient
+	subscriptionId string
+
+	// a lock per Resource Provider, so that concurrent requests only register it once
+	locks *mutexkv.MutexKV
+
+	registeredLock sync.RWMutex
+	registered     map[string]struct{}
+}
+
+func newResourceProviderRegistration(client resources.ProvidersClient, subscriptionId string) *resourceProviderRegistration {
+	return &resourceProviderRegistration{
+		client:         client,
+		subscriptionId: subscriptionId,
+		locks:          mutexkv.NewMutexKV(),
+		registered:     make(map[string]struct{}),
+	}
+}
+
+// setRegistrationStates records which of the listed Resource Providers are already registered,
+// to avoid looking each of them up again the first time they're used.
+func (r *resourceProviderRegistration) setRegistrationStates(providerList []resources.Provider) {
+	for _, p := range providerList {
+		if p.Namespace == nil || p.RegistrationState == nil {
+			continue
+		}
+
+		if strings.EqualFold(*p.RegistrationState, "Registered") {
+			r.markRegistered(*p.Namespace)
+		}
+	}
+}
+
+func (r *resourceProviderRegistration) isRegistered(namespace string) bool {
+	r.registeredLock.RLock()
+	defer r.registeredLock.RUnlock()
+
+	_, ok := r.registered[strings.ToLower(namespace)]
+	return ok
+}
+
+func (r *resourceProviderRegistration) markRegistered(namespace string) {
+	r.registeredLock.Lock()
+	defer r.registeredLock.Unlock()
+
+	r.registered[strings.ToLower(namespace)] = struct{}{}
+}
+
+// ensureRegistered registers the specified Resource Provider with the Subscription if it isn't already,
+// and waits for the registration to complete.
+func (r *resourceProviderRegistration) ensureRegistered(ctx context.Context, namespace string) error {
+	if r.isRegistered(namespace) {
+		return nil
+	}
+
+	key := strings.ToLower(namespace)
+	r.locks.Lock(key)
+	defer r.locks.Unlock(key)
+
+	// another request may have registered it whilst we were waiting for the lock
+	if r.isRegistered(namespace) {
+		return nil
+	}
+
+	provider, err := r.client.Get(ctx, namespace, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving the registration state of the Resource Provider %q: %+v", namespace, err)
+	}
+
+	if provider.RegistrationState == nil || !strings.EqualFold(*provider.RegistrationState, "Registered") {
+		log.Printf("[DEBUG] Registering the Resource Provider %q with Subscription %q", namespace, r.subscriptionId)
+		if _, err := r.client.Register(ctx, namespace); err != nil {
+			return fmt.Errorf("Cannot register the Resource Provider %q with Azure Resource Manager: %+v", namespace, err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"NotRegistered", "Registering", "Unregistered"},
+			Target:  []string{"Registered"},
+			Refresh: resourceProviderRegistrationStateRefreshFunc(ctx, r.client, namespace),
+			Timeout: resourceProviderRegistrationTimeout,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for the Resource Provider %q to be registered: %+v", namespace, err)
+		}
+	}
+
+	r.markRegistered(namespace)
+	return nil
+}
+
+// ensureRegisteredAll registers each of the specified Resource Providers in parallel, returning
+// all of the errors which occurred.
+func (r *resourceProviderRegistration) ensureRegisteredAll(ctx context.Context, namespaces []string) error {
+	var errors *multierror.Error
+	var errorsLock sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, namespace := range namespaces {
+		wg.Add(1)
+		go func(namespace string) {
+			defer wg.Done()
+
+			if err := r.ensureRegistered(ctx, namespace); err != nil {
+				errorsLock.Lock()
+				errors = multierror.Append(errors, err)
+				errorsLock.Unlock()
+			}
+		}(namespace)
+	}
+
+	wg.Wait()
+
+	return errors.ErrorOrNil()
+}
+
+func resourceProviderRegistrationStateRefreshFunc(ctx context.Context, client resources.ProvidersClient, namespace string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		provider, err := client.Get(ctx, namespace, "")
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving the registration state of the Resource Provider %q: %+v", namespace, err)
+		}
+
+		state := ""
+		if provider.RegistrationState != nil {
+			state = *provider.RegistrationState
+		}
+
+		return provider, state, nil
+	}
+}
+
+// withResourceProviderRegistration returns a SendDecorator which ensures the Resource Provider a request
+// is for is registered with the Subscription before the request is sent.
+func withResourceProviderRegistration(registration *resourceProviderRegistration) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if namespace := resourceProviderNamespaceForRequest(r, registration.subscriptionId); namespace != "" {
+				if err := registration.ensureRegistered(r.Context(), namespace); err != nil {
+					return nil, err
+				}
+			}
+
+			return s.Do(r)
+		})
+	}
+}
+
+// resourceProviderNamespaceForRequest returns the namespace of the Resource Provider which serves the
+// request, when it's to a Resource within the specified Subscription. For Extension Resources (such as
+// Role Assignments on a Virtual Machine) this is the last namespace in the path.
+func resourceProviderNamespaceForRequest(r *http.Request, subscriptionId string) string {
+	if r == nil || r.URL == nil || subscriptionId == "" {
+		return ""
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segments) < 4 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[1], subscriptionId) {
+		return ""
+	}
+
+	namespace := ""
+	for i := 2; i < len(segments)-1; i++ {
+		if strings.EqualFold(segments[i], "providers") {
+			namespace = segments[i+1]
+		}
+	}
+
+	return namespace
+}
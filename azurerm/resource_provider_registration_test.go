@@ -0,0 +1,181 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// testResourceProvidersServer is a stand-in for the Resource Providers API, where each Resource Provider
+// finishes registering after it's been polled once
+type testResourceProvidersServer struct {
+	sync.Mutex
+	states        map[string]string
+	registrations map[string]int
+}
+
+func (s *testResourceProvidersServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	namespace := segments[3]
+	state, ok := s.states[namespace]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"error":{"code":"InvalidResourceNamespace","message":"The resource namespace '%s' is invalid."}}`, namespace)
+		return
+	}
+
+	if len(segments) == 5 && segments[4] == "register" {
+		s.registrations[namespace]++
+		s.states[namespace] = "Registering"
+	} else if state == "Registering" {
+		s.states[namespace] = "Registered"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"namespace":%q,"registrationState":%q}`, namespace, state)
+}
+
+func testResourceProviderRegistration(t *testing.T, states map[string]string) (*resourceProviderRegistration, *testResourceProvidersServer, func()) {
+	handler := &testResourceProvidersServer{
+		states:        states,
+		registrations: make(map[string]int),
+	}
+	server := httptest.NewServer(handler)
+
+	client := resources.NewProvidersClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	client.Authorizer = autorest.NullAuthorizer{}
+	client.RetryAttempts = 1
+
+	return newResourceProviderRegistration(client, "00000000-0000-0000-0000-000000000000"), handler, server.Close
+}
+
+func TestResourceProviderRegistration_EnsureRegisteredAll(t *testing.T) {
+	registration, server, closer := testResourceProviderRegistration(t, map[string]string{
+		"Microsoft.Compute": "NotRegistered",
+		"Microsoft.Network": "Registered",
+		"Microsoft.Storage": "NotRegistered",
+	})
+	defer closer()
+
+	namespaces := []string{"Microsoft.Compute", "Microsoft.Network", "Microsoft.Storage", "Microsoft.Compute"}
+	if err := registration.ensureRegisteredAll(context.Background(), namespaces); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := map[string]int{
+		"Microsoft.Compute": 1,
+		"Microsoft.Storage": 1,
+	}
+	if len(server.registrations) != len(expected) {
+		t.Fatalf("Expected %d Resource Providers to be registered but got %+v", len(expected), server.registrations)
+	}
+	for namespace, count := range expected {
+		if server.registrations[namespace] != count {
+			t.Fatalf("Expected %q to be registered %d time(s) but got %d", namespace, count, server.registrations[namespace])
+		}
+	}
+
+	for _, namespace := range namespaces {
+		if !registration.isRegistered(namespace) {
+			t.Fatalf("Expected %q to be marked as Registered", namespace)
+		}
+	}
+}
+
+func TestResourceProviderRegistration_AggregatesErrors(t *testing.T) {
+	registration, _, closer := testResourceProviderRegistration(t, map[string]string{
+		"Microsoft.Compute": "NotRegistered",
+	})
+	defer closer()
+
+	err := registration.ensureRegisteredAll(context.Background(), []string{"Microsoft.Compute", "Microsoft.Invalid", "Microsoft.Unknown"})
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	for _, namespace := range []string{"Microsoft.Invalid", "Microsoft.Unknown"} {
+		if !strings.Contains(err.Error(), namespace) {
+			t.Fatalf("Expected the error to mention %q but got: %+v", namespace, err)
+		}
+	}
+
+	if !registration.isRegistered("Microsoft.Compute") {
+		t.Fatalf("Expected %q to be registered despite the other errors", "Microsoft.Compute")
+	}
+}
+
+func TestResourceProviderRegistration_SetRegistrationStates(t *testing.T) {
+	registration := newResourceProviderRegistration(resources.ProvidersClient{}, "00000000-0000-0000-0000-000000000000")
+	registration.setRegistrationStates([]resources.Provider{
+		{Namespace: utils.String("Microsoft.Compute"), RegistrationState: utils.String("Registered")},
+		{Namespace: utils.String("Microsoft.Network"), RegistrationState: utils.String("NotRegistered")},
+		{Namespace: utils.String("Microsoft.Storage")},
+	})
+
+	expected := map[string]bool{
+		"Microsoft.Compute": true,
+		"microsoft.compute": true,
+		"Microsoft.Network": false,
+		"Microsoft.Storage": false,
+	}
+	for namespace, registered := range expected {
+		if actual := registration.isRegistered(namespace); actual != registered {
+			t.Fatalf("Expected %q to be registered: %t but got %t", namespace, registered, actual)
+		}
+	}
+}
+
+func TestResourceProviderNamespaceForRequest(t *testing.T) {
+	subscriptionId := "00000000-0000-0000-0000-000000000000"
+	testCases := []struct {
+		Url      string
+		Expected string
+	}{
+		{
+			Url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2017-05-10",
+			Expected: "",
+		},
+		{
+			Url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers?api-version=2017-05-10",
+			Expected: "",
+		},
+		{
+			Url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/example?api-version=2017-12-01",
+			Expected: "Microsoft.Compute",
+		},
+		{
+			Url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/example/providers/Microsoft.Authorization/roleAssignments/example",
+			Expected: "Microsoft.Authorization",
+		},
+		{
+			Url:      "https://management.azure.com/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/providers/Microsoft.Network/locations/westeurope/usages",
+			Expected: "Microsoft.Network",
+		},
+		{
+			Url:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example",
+			Expected: "",
+		},
+		{
+			Url:      "https://example.vault.azure.net/secrets/example",
+			Expected: "",
+		},
+	}
+
+	for _, v := range testCases {
+		req, _ := http.NewRequest(http.MethodGet, v.Url, nil)
+		if actual := resourceProviderNamespaceForRequest(req, subscriptionId); actual != v.Expected {
+			t.Fatalf("Expected the namespace for %q to be %q but got %q", v.Url, v.Expected, actual)
+		}
+	}
+}
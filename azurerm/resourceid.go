@@ -136,19 +136,19 @@ func composeAzureResourceID(idObj *ResourceID) (id string, err error) {
 }
 
 func parseNetworkSecurityGroupName(networkSecurityGroupId string) (string, error) {
-	id, err := parseAzureResourceID(networkSecurityGroupId)
+	id, err := parseNetworkSecurityGroupID(networkSecurityGroupId)
 	if err != nil {
 		return "", fmt.Errorf("[ERROR] Unable to Parse Network Security Group ID '%s': %+v", networkSecurityGroupId, err)
 	}
 
-	return id.Path["networkSecurityGroups"], nil
+	return id.Name, nil
 }
 
 func parseRouteTableName(routeTableId string) (string, error) {
-	id, err := parseAzureResourceID(routeTableId)
+	id, err := parseRouteTableID(routeTableId)
 	if err != nil {
 		return "", fmt.Errorf("[ERROR] Unable to parse Route Table ID '%s': %+v", routeTableId, err)
 	}
 
-	return id.Path["routeTables"], nil
+	return id.Name, nil
 }
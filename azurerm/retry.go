@@ -0,0 +1,226 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// defaultMaxRetries is the number of times a throttled or transient response is retried
+	defaultMaxRetries = 5
+
+	// defaultMaxRetryDelay is the upper bound on the exponential backoff between retries
+	defaultMaxRetryDelay = 60 * time.Second
+
+	// retryBaseDelay is the delay before the first retry when the response doesn't specify one
+	retryBaseDelay = 2 * time.Second
+
+	// rateLimitRemainingHeaderPrefix is the prefix of the headers ARM uses to report how many
+	// requests are left in the current throttling window, e.g. `x-ms-ratelimit-remaining-subscription-reads`
+	rateLimitRemainingHeaderPrefix = "x-ms-ratelimit-remaining-"
+
+	// rateLimitRemainingWarningThreshold is the number of remaining requests below which we log a warning
+	rateLimitRemainingWarningThreshold = 100
+)
+
+// retryOptions configures the behaviour of withThrottlingRetries
+type retryOptions struct {
+	maxRetries int
+	baseDelay  time.Duration
+	maxDelay   time.Duration
+}
+
+// withThrottlingRetries returns a SendDecorator which retries requests which were throttled (429)
+// or failed with a transient error, backing off exponentially between attempts. The delay requested
+// by the `Retry-After` header takes precedence over the backoff - and when ARM reports that the
+// throttling window has been exhausted via the `x-ms-ratelimit-remaining-*` headers we wait for the
+// maximum delay, since retrying sooner will only be throttled again.
+func withThrottlingRetries(opts retryOptions) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (resp *http.Response, err error) {
+			rr := autorest.NewRetriableRequest(r)
+			for attempt := 0; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+
+				resp, err = s.Do(rr.Request())
+				logRateLimitRemaining(r, resp)
+
+				if attempt >= opts.maxRetries || !shouldRetryRequest(r, resp, err) {
+					return resp, err
+				}
+
+				delay := retryDelay(resp, attempt, opts)
+				log.Printf("[DEBUG] Retrying %s request to %s in %s (attempt %d of %d)", r.Method, r.URL, delay, attempt+1, opts.maxRetries)
+
+				// drain the body so the underlying connection can be re-used
+				if resp != nil && resp.Body != nil {
+					autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+				}
+
+				if !waitForRetry(r, delay) {
+					// the body of the response has been closed, so the cancellation is returned instead
+					if ctxErr := r.Context().Err(); ctxErr != nil {
+						return nil, ctxErr
+					}
+					return nil, fmt.Errorf("The %s request to %s was cancelled whilst waiting to retry it", r.Method, r.URL)
+				}
+			}
+		})
+	}
+}
+
+// shouldRetryRequest determines if the request should be retried based on the response or error returned.
+func shouldRetryRequest(r *http.Request, resp *http.Response, err error) bool {
+	// failing to obtain a token will never succeed on a retry
+	if autorest.IsTokenRefreshError(err) {
+		return false
+	}
+
+	if resp == nil {
+		// a transient network failure - only retry if the request is safe to re-send
+		return err != nil && isIdempotentMethod(r.Method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		// throttled requests are rejected before being processed, so they're always safe to retry
+		return true
+	case http.StatusRequestTimeout,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return isIdempotentMethod(r.Method)
+	}
+
+	return false
+}
+
+// isIdempotentMethod returns whether it's safe to re-send a request using the specified method.
+// ARM's PUT, PATCH and DELETE operations are idempotent, however POST actions may not be.
+func isIdempotentMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// retryDelay determines how long to wait before the next attempt of the request.
+func retryDelay(resp *http.Response, attempt int, opts retryOptions) time.Duration {
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return delay
+		}
+
+		if remaining, ok := rateLimitRemaining(resp); ok && remaining <= 0 {
+			return opts.maxDelay
+		}
+	}
+
+	backoff := float64(opts.baseDelay) * math.Pow(2, float64(attempt))
+	if backoff > float64(opts.maxDelay) {
+		backoff = float64(opts.maxDelay)
+	}
+
+	// add some jitter so that parallel requests which were throttled together don't retry together
+	jitter := 0.0
+	if opts.baseDelay > 0 {
+		jitter = rand.Float64() * float64(opts.baseDelay)
+	}
+
+	delay := time.Duration(backoff + jitter)
+	if delay > opts.maxDelay {
+		delay = opts.maxDelay
+	}
+	return delay
+}
+
+// parseRetryAfter parses the value of a `Retry-After` header, which can either be a number of seconds
+// or a HTTP Date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// rateLimitRemaining returns the lowest number of remaining requests reported by any of the
+// `x-ms-ratelimit-remaining-*` headers on the response.
+func rateLimitRemaining(resp *http.Response) (int, bool) {
+	found := false
+	lowest := 0
+
+	for key, values := range resp.Header {
+		if !strings.HasPrefix(strings.ToLower(key), rateLimitRemainingHeaderPrefix) {
+			continue
+		}
+
+		for _, value := range values {
+			remaining, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil {
+				continue
+			}
+
+			if !found || remaining < lowest {
+				lowest = remaining
+				found = true
+			}
+		}
+	}
+
+	return lowest, found
+}
+
+func logRateLimitRemaining(r *http.Request, resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	if remaining, ok := rateLimitRemaining(resp); ok && remaining < rateLimitRemainingWarningThreshold {
+		log.Printf("[WARN] Only %d requests remain in the current ARM throttling window (last request: %s %s)", remaining, r.Method, r.URL)
+	}
+}
+
+// waitForRetry waits for the specified delay - returning false if the request was cancelled in the meantime.
+func waitForRetry(r *http.Request, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-r.Context().Done():
+		return false
+	case <-r.Cancel:
+		return false
+	}
+}
@@ -0,0 +1,310 @@
+package azurerm
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func testRetrySender(maxRetries int) autorest.Sender {
+	return autorest.CreateSender(withThrottlingRetries(retryOptions{
+		maxRetries: maxRetries,
+		baseDelay:  time.Millisecond,
+		maxDelay:   10 * time.Millisecond,
+	}))
+}
+
+func TestWithThrottlingRetries(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Method           string
+		StatusCodes      []int
+		MaxRetries       int
+		ExpectedStatus   int
+		ExpectedRequests int
+	}{
+		{
+			Name:             "Success",
+			Method:           http.MethodGet,
+			StatusCodes:      []int{http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Throttled then Success",
+			Method:           http.MethodPut,
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "Transient Error then Success",
+			Method:           http.MethodGet,
+			StatusCodes:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 2,
+		},
+		{
+			Name:             "Retries Exhausted",
+			Method:           http.MethodGet,
+			StatusCodes:      []int{http.StatusTooManyRequests},
+			MaxRetries:       2,
+			ExpectedStatus:   http.StatusTooManyRequests,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "Retries Disabled",
+			Method:           http.MethodGet,
+			StatusCodes:      []int{http.StatusTooManyRequests},
+			MaxRetries:       0,
+			ExpectedStatus:   http.StatusTooManyRequests,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Not Found isn't retried",
+			Method:           http.MethodGet,
+			StatusCodes:      []int{http.StatusNotFound},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusNotFound,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Transient Error on a POST isn't retried",
+			Method:           http.MethodPost,
+			StatusCodes:      []int{http.StatusInternalServerError, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusInternalServerError,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Throttled POST is retried",
+			Method:           http.MethodPost,
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 2,
+		},
+	}
+
+	for _, v := range testCases {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			index := requests
+			if index >= len(v.StatusCodes) {
+				index = len(v.StatusCodes) - 1
+			}
+			requests++
+			w.WriteHeader(v.StatusCodes[index])
+		}))
+
+		req, _ := http.NewRequest(v.Method, server.URL, strings.NewReader("{}"))
+		resp, err := testRetrySender(v.MaxRetries).Do(req)
+		server.Close()
+
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+		}
+
+		if resp.StatusCode != v.ExpectedStatus {
+			t.Fatalf("Expected the status code for %q to be %d but got %d", v.Name, v.ExpectedStatus, resp.StatusCode)
+		}
+
+		if requests != v.ExpectedRequests {
+			t.Fatalf("Expected %d requests for %q but got %d", v.ExpectedRequests, v.Name, requests)
+		}
+	}
+}
+
+func TestWithThrottlingRetries_ResendsBody(t *testing.T) {
+	bodies := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"location":"westeurope"}`))
+	if _, err := testRetrySender(3).Do(req); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if len(bodies) != 2 {
+		t.Fatalf("Expected 2 requests but got %d", len(bodies))
+	}
+
+	for i, body := range bodies {
+		if body != `{"location":"westeurope"}` {
+			t.Fatalf("Expected the body of request %d to be re-sent but got %q", i, body)
+		}
+	}
+}
+
+func TestWithThrottlingRetries_CancelledWhilstWaiting(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := testRetrySender(3).Do(req.WithContext(ctx))
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Expected the cancellation to be returned but got: %+v", err)
+	}
+
+	if resp != nil {
+		t.Fatalf("Expected no response to be returned but got %d", resp.StatusCode)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	opts := retryOptions{
+		maxRetries: 5,
+		baseDelay:  time.Second,
+		maxDelay:   30 * time.Second,
+	}
+
+	testCases := []struct {
+		Name     string
+		Headers  map[string]string
+		Attempt  int
+		Minimum  time.Duration
+		Maximum  time.Duration
+		Response bool
+	}{
+		{
+			Name:     "No Response",
+			Attempt:  0,
+			Minimum:  time.Second,
+			Maximum:  2 * time.Second,
+			Response: false,
+		},
+		{
+			Name:     "Exponential Backoff",
+			Attempt:  2,
+			Minimum:  4 * time.Second,
+			Maximum:  5 * time.Second,
+			Response: true,
+		},
+		{
+			Name:     "Backoff is capped",
+			Attempt:  10,
+			Minimum:  30 * time.Second,
+			Maximum:  30 * time.Second,
+			Response: true,
+		},
+		{
+			Name: "Retry-After in Seconds",
+			Headers: map[string]string{
+				"Retry-After": "17",
+			},
+			Attempt:  0,
+			Minimum:  17 * time.Second,
+			Maximum:  17 * time.Second,
+			Response: true,
+		},
+		{
+			Name: "Rate Limit Exhausted",
+			Headers: map[string]string{
+				"x-ms-ratelimit-remaining-subscription-writes": "0",
+			},
+			Attempt:  0,
+			Minimum:  30 * time.Second,
+			Maximum:  30 * time.Second,
+			Response: true,
+		},
+		{
+			Name: "Rate Limit Remaining",
+			Headers: map[string]string{
+				"x-ms-ratelimit-remaining-subscription-reads": "11999",
+			},
+			Attempt:  0,
+			Minimum:  time.Second,
+			Maximum:  2 * time.Second,
+			Response: true,
+		},
+	}
+
+	for _, v := range testCases {
+		var resp *http.Response
+		if v.Response {
+			resp = &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{},
+			}
+			for key, value := range v.Headers {
+				resp.Header.Set(key, value)
+			}
+		}
+
+		actual := retryDelay(resp, v.Attempt, opts)
+		if actual < v.Minimum || actual > v.Maximum {
+			t.Fatalf("Expected the delay for %q to be between %s and %s but got %s", v.Name, v.Minimum, v.Maximum, actual)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	testCases := []struct {
+		Value    string
+		Expected time.Duration
+		Valid    bool
+	}{
+		{
+			Value: "",
+			Valid: false,
+		},
+		{
+			Value: "invalid",
+			Valid: false,
+		},
+		{
+			Value: "-1",
+			Valid: false,
+		},
+		{
+			Value:    "0",
+			Expected: 0,
+			Valid:    true,
+		},
+		{
+			Value:    "30",
+			Expected: 30 * time.Second,
+			Valid:    true,
+		},
+		{
+			Value:    "Wed, 21 Oct 2015 07:28:00 GMT",
+			Expected: 0,
+			Valid:    true,
+		},
+	}
+
+	for _, v := range testCases {
+		actual, valid := parseRetryAfter(v.Value)
+		if valid != v.Valid {
+			t.Fatalf("Expected %q to be valid: %t but got %t", v.Value, v.Valid, valid)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q to be parsed as %s but got %s", v.Value, v.Expected, actual)
+		}
+	}
+}
@@ -3,8 +3,10 @@ package azurerm
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func tagsSchema() *schema.Schema {
@@ -67,19 +69,173 @@ func validateAzureRMTags(v interface{}, k string) (ws []string, es []error) {
 	return
 }
 
-func expandTags(tagsMap map[string]interface{}) *map[string]*string {
-	output := make(map[string]*string, len(tagsMap))
+// expandProviderDefaultTags returns the tags specified in the `default_tags` block on the Provider
+func expandProviderDefaultTags(input []interface{}) map[string]string {
+	output := make(map[string]string)
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	block := input[0].(map[string]interface{})
+	for k, v := range block["tags"].(map[string]interface{}) {
+		//Validate should have ignored this error already
+		value, _ := tagValueToString(v)
+		output[k] = value
+	}
+
+	return output
+}
+
+// expandTags converts the tags specified on the resource into the format used by the SDK - merging in the
+// `default_tags` configured on the Provider, with the values specified on the resource taking precedence.
+func expandTags(tagsMap map[string]interface{}, meta interface{}) *map[string]*string {
+	client := meta.(*ArmClient)
+	output := make(map[string]*string, len(client.defaultTags)+len(tagsMap))
 
+	configuredKeys := make(map[string]struct{}, len(tagsMap))
 	for i, v := range tagsMap {
 		//Validate should have ignored this error already
 		value, _ := tagValueToString(v)
 		output[i] = &value
+		configuredKeys[strings.ToLower(i)] = struct{}{}
+	}
+
+	// the default tags are usually in the configuration already (see configWithDefaultTags), but aren't where the
+	// tags weren't known when planning
+	for i, v := range client.defaultTags {
+		if !client.isDefaultTagInherited(configuredKeys, i) {
+			continue
+		}
+
+		value := v
+		output[i] = &value
 	}
 
 	return &output
 }
 
-func flattenAndSetTags(d *schema.ResourceData, tagsMap *map[string]*string) {
+// isDefaultTagInherited returns whether the default tag with the specified key is assigned to a resource, given the
+// (lower-cased) keys of the tags specified on it. Tag keys are case-insensitive in Azure, so a default tag specified
+// on the resource using a different case is overridden by it - and tags matching `ignore_tags` are left as they are.
+func (c *ArmClient) isDefaultTagInherited(configuredKeys map[string]struct{}, key string) bool {
+	if _, ok := configuredKeys[strings.ToLower(key)]; ok {
+		return false
+	}
+
+	return !c.ignoreTags.isIgnored(key)
+}
+
+// flattenAndSetTags sets the tags returned from the API into the state, omitting any matching the `ignore_tags`
+// configured on the Provider. Tags inherited from the `default_tags` configured on the Provider are set too, so
+// that a resource which is missing one of them shows as a diff (see configWithDefaultTags).
+func flattenAndSetTags(d *schema.ResourceData, tagsMap *map[string]*string, meta interface{}) {
+	if tagsMap == nil {
+		d.Set("tags", make(map[string]interface{}))
+		return
+	}
+
+	client := meta.(*ArmClient)
+	output := make(map[string]interface{}, len(*tagsMap))
+
+	for i, v := range *tagsMap {
+		// tags managed outside of Terraform are never written to the state
+		if client.ignoreTags.isIgnored(i) {
+			continue
+		}
+
+		output[i] = *v
+	}
+
+	d.Set("tags", output)
+}
+
+// configWithDefaultTags returns the configuration for the resource with the `default_tags` configured on the
+// Provider merged into its `tags` (unless they're specified on the resource, or are ignored). Since the tags
+// assigned to the resource are recorded in the state, a resource which doesn't have one of the default tags
+// (for example because it was added after the resource was created) or has a different value then shows as
+// a diff, and is updated to include it.
+//
+// Changing the tags of some resources replaces them - these resources retain the values they were created
+// with, rather than being replaced when the default tags change.
+func (p *armProvider) configWithDefaultTags(info *terraform.InstanceInfo, s *terraform.InstanceState, c *terraform.ResourceConfig) *terraform.ResourceConfig {
+	resource, ok := p.ResourcesMap[info.Type]
+	if !ok || c == nil {
+		return c
+	}
+
+	tags, ok := resource.Schema["tags"]
+	if !ok || tags.Type != schema.TypeMap || !tags.Optional {
+		return c
+	}
+
+	client, ok := p.Meta().(*ArmClient)
+	if !ok || len(client.defaultTags) == 0 {
+		return c
+	}
+
+	// the values of the tags aren't known until apply time
+	if c.IsComputed("tags") {
+		return c
+	}
+
+	merged := make(map[string]interface{})
+	if v, ok := c.Get("tags"); ok {
+		switch configured := v.(type) {
+		case map[string]interface{}:
+			for key, value := range configured {
+				merged[key] = value
+			}
+		case []map[string]interface{}:
+			for _, block := range configured {
+				for key, value := range block {
+					merged[key] = value
+				}
+			}
+		default:
+			return c
+		}
+	}
+
+	configuredKeys := make(map[string]struct{}, len(merged))
+	for key := range merged {
+		configuredKeys[strings.ToLower(key)] = struct{}{}
+	}
+
+	existing := s != nil && s.ID != ""
+	for key, value := range client.defaultTags {
+		if !client.isDefaultTagInherited(configuredKeys, key) {
+			continue
+		}
+
+		if tags.ForceNew && existing {
+			if v, ok := s.Attributes["tags."+key]; ok {
+				merged[key] = v
+			}
+			continue
+		}
+
+		merged[key] = value
+	}
+
+	output := &terraform.ResourceConfig{
+		ComputedKeys: c.ComputedKeys,
+		Raw:          make(map[string]interface{}, len(c.Raw)+1),
+		Config:       make(map[string]interface{}, len(c.Config)+1),
+	}
+	for k, v := range c.Raw {
+		output.Raw[k] = v
+	}
+	for k, v := range c.Config {
+		output.Config[k] = v
+	}
+	output.Raw["tags"] = merged
+	output.Config["tags"] = merged
+
+	return output
+}
+
+// flattenAndSetTagsForDataSource sets all of the tags returned from the API into the state.
+func flattenAndSetTagsForDataSource(d *schema.ResourceData, tagsMap *map[string]*string) {
 	if tagsMap == nil {
 		d.Set("tags", make(map[string]interface{}))
 		return
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestValidateMaximumNumberOfARMTags(t *testing.T) {
@@ -74,7 +77,7 @@ func TestExpandARMTags(t *testing.T) {
 	testData["key2"] = 21
 	testData["key3"] = "value3"
 
-	tempExpanded := expandTags(testData)
+	tempExpanded := expandTags(testData, &ArmClient{})
 	expanded := *tempExpanded
 
 	if len(expanded) != 3 {
@@ -95,3 +98,146 @@ func TestExpandARMTags(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandARMTagsWithDefaultTags(t *testing.T) {
+	testData := map[string]interface{}{
+		"environment": "production",
+		"application": "web",
+		"Team":        "networking",
+	}
+	client := &ArmClient{
+		defaultTags: map[string]string{
+			"cost_center": "1234",
+			"environment": "development",
+			"team":        "platform",
+			"owner":       "platform",
+		},
+		ignoreTags: ignoreTagsConfig{
+			keys: []string{"Owner"},
+		},
+	}
+
+	expanded := *expandTags(testData, client)
+
+	// tag keys are case-insensitive, so `team` is overridden by `Team` - and `owner` is managed outside of Terraform
+	expected := map[string]string{
+		"application": "web",
+		"cost_center": "1234",
+		"environment": "production",
+		"Team":        "networking",
+	}
+
+	if len(expanded) != len(expected) {
+		t.Fatalf("Expected %d results in expanded tag map, got %d", len(expected), len(expanded))
+	}
+
+	for k, v := range expected {
+		if expanded[k] == nil || *expanded[k] != v {
+			t.Fatalf("Expanded value %q incorrect: expected %q, got %v", k, v, expanded[k])
+		}
+	}
+}
+
+func TestConfigWithDefaultTags(t *testing.T) {
+	provider := Provider().(*armProvider)
+	provider.SetMeta(&ArmClient{
+		defaultTags: map[string]string{
+			"cost_center": "1234",
+			"environment": "development",
+			"owner":       "platform",
+		},
+		ignoreTags: ignoreTagsConfig{
+			keys: []string{"owner"},
+		},
+	})
+
+	cases := []struct {
+		Name         string
+		ResourceType string
+		State        *terraform.InstanceState
+		Expected     map[string]string
+	}{
+		{
+			Name:         "New Resource",
+			ResourceType: "azurerm_resource_group",
+			Expected: map[string]string{
+				"cost_center": "1234",
+				"Environment": "production",
+			},
+		},
+		{
+			Name:         "Existing Resource",
+			ResourceType: "azurerm_resource_group",
+			State: &terraform.InstanceState{
+				ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources",
+				Attributes: map[string]string{
+					"tags.%":           "1",
+					"tags.Environment": "production",
+				},
+			},
+			Expected: map[string]string{
+				"cost_center": "1234",
+				"Environment": "production",
+			},
+		},
+		{
+			Name:         "Existing Resource Replaced When The Tags Change",
+			ResourceType: "azurerm_search_service",
+			State: &terraform.InstanceState{
+				ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Search/searchServices/example",
+				Attributes: map[string]string{
+					"tags.%":           "1",
+					"tags.Environment": "production",
+				},
+			},
+			Expected: map[string]string{
+				"Environment": "production",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"tags": map[string]interface{}{
+				"Environment": "production",
+			},
+		})
+		if err != nil {
+			t.Fatalf("Error building the configuration for %q: %+v", tc.Name, err)
+		}
+
+		c := provider.configWithDefaultTags(&terraform.InstanceInfo{Type: tc.ResourceType}, tc.State, terraform.NewResourceConfig(raw))
+
+		v, _ := c.Get("tags")
+		actual := v.(map[string]interface{})
+		if len(actual) != len(tc.Expected) {
+			t.Fatalf("Expected %d tags for %q but got %d: %+v", len(tc.Expected), tc.Name, len(actual), actual)
+		}
+
+		for k, v := range tc.Expected {
+			if actual[k] != v {
+				t.Fatalf("Expected the tag %q for %q to be %q but got %v", k, tc.Name, v, actual[k])
+			}
+		}
+	}
+}
+
+func TestExpandProviderDefaultTags(t *testing.T) {
+	if actual := expandProviderDefaultTags([]interface{}{}); len(actual) != 0 {
+		t.Fatalf("Expected no default tags but got %+v", actual)
+	}
+
+	input := []interface{}{
+		map[string]interface{}{
+			"tags": map[string]interface{}{
+				"cost_center": 1234,
+				"owner":       "platform",
+			},
+		},
+	}
+	actual := expandProviderDefaultTags(input)
+
+	if actual["cost_center"] != "1234" || actual["owner"] != "platform" || len(actual) != 2 {
+		t.Fatalf("Unexpected default tags: %+v", actual)
+	}
+}
@@ -1,11 +1,81 @@
 package main
 
 import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
 	"github.com/hashicorp/terraform/plugin"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %+v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: azurerm.Provider})
 }
+
+// export writes the configuration for an existing Resource Group (and the resources within it) to `main.tf`, and
+// the commands to import them into the State to `import.sh`, e.g.
+//
+//	terraform-provider-azurerm export -resource-group=example-resources -out=./example
+func export(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	resourceGroup := flags.String("resource-group", "", "The name of the Resource Group to export")
+	out := flags.String("out", ".", "The directory to write main.tf and import.sh to")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *resourceGroup == "" {
+		flags.Usage()
+		return fmt.Errorf("-resource-group must be specified")
+	}
+
+	// as with Terraform, the logs are only output when `TF_LOG` is set
+	if os.Getenv("TF_LOG") == "" {
+		log.SetOutput(ioutil.Discard)
+	}
+
+	var config, imports bytes.Buffer
+	imports.WriteString("#!/bin/sh\nset -e\n\n")
+	if err := azurerm.ExportResourceGroup(*resourceGroup, &config, &imports); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(*out, 0755); err != nil {
+		return fmt.Errorf("Error creating %q: %+v", *out, err)
+	}
+
+	if err := writeNewFile(filepath.Join(*out, "main.tf"), config.Bytes(), 0644); err != nil {
+		return err
+	}
+
+	return writeNewFile(filepath.Join(*out, "import.sh"), imports.Bytes(), 0755)
+}
+
+// writeNewFile writes the contents to a new file, since existing files are never overwritten
+func writeNewFile(path string, contents []byte, perm os.FileMode) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(contents); err != nil {
+		f.Close()
+		return fmt.Errorf("Error writing %q: %+v", path, err)
+	}
+
+	return f.Close()
+}